@@ -2,5 +2,8 @@ package common_abi
 
 import _ "embed"
 
+// the typed bindings of all ABIs are generated into the bindings.go files of the ABI packages
+//go:generate go run ./gen
+
 //go:embed IFlareContractRegistry.abi
 var IFlareContractRegistry string
@@ -2,6 +2,9 @@ package flare_abi
 
 import _ "embed"
 
+//go:embed IFtso.abi
+var IFtso string
+
 //go:embed IFtsoManager.abi
 var IFtsoManager string
 
@@ -13,3 +16,6 @@ var IPriceSubmitter string
 
 //go:embed IVoterWhitelister.abi
 var IVoterWhitelister string
+
+//go:embed IWNat.abi
+var IWNat string
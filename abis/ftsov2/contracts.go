@@ -0,0 +1,15 @@
+package ftsov2_abi
+
+import _ "embed"
+
+//go:embed ISubmission.abi
+var ISubmission string
+
+//go:embed IFlareSystemsManager.abi
+var IFlareSystemsManager string
+
+//go:embed IRelay.abi
+var IRelay string
+
+//go:embed IFastUpdater.abi
+var IFastUpdater string
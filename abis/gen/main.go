@@ -0,0 +1,73 @@
+// Command gen is used to generate the typed go bindings of the smart-contract ABIs. It is run by go generate in the
+// abis package: each ABI directory gets a bindings.go file in its package with a binding per *.abi file, named after
+// the file without the interface "I" prefix, e.g. IFtsoManager.abi is bound as FtsoManager
+package main
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// packages are the ABI directories relative to the abis package and their go package names
+var packages = map[string]string{
+	".":        "common_abi",
+	"flare":    "flare_abi",
+	"songbird": "songbird_abi",
+	"ftsov2":   "ftsov2_abi",
+}
+
+// bindingsFile is the generated file name in each ABI directory
+const bindingsFile = "bindings.go"
+
+func main() {
+	dirs := make([]string, 0, len(packages))
+	for dir := range packages {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+
+	for _, dir := range dirs {
+		if err := generate(dir, packages[dir]); err != nil {
+			log.Fatalf("generate %s bindings: %s", dir, err.Error())
+		}
+	}
+}
+
+// generate is used to bind all ABIs of the directory into one file, so the tuple types shared by the contracts are
+// declared once
+func generate(dir string, pkg string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.abi"))
+	if err != nil {
+		return err
+	}
+	sort.Strings(files)
+
+	types := make([]string, 0, len(files))
+	abis := make([]string, 0, len(files))
+	bytecodes := make([]string, 0, len(files))
+	fsigs := make([]map[string]string, 0, len(files))
+
+	for _, f := range files {
+		data, err := os.ReadFile(f)
+		if err != nil {
+			return err
+		}
+
+		types = append(types, strings.TrimPrefix(strings.TrimSuffix(filepath.Base(f), ".abi"), "I"))
+		abis = append(abis, string(data))
+		bytecodes = append(bytecodes, "")
+		fsigs = append(fsigs, nil)
+	}
+
+	code, err := bind.Bind(types, abis, bytecodes, fsigs, pkg, bind.LangGo, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, bindingsFile), []byte(code), 0o644)
+}
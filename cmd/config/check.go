@@ -0,0 +1,49 @@
+package config
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"oracle-flare/internal"
+)
+
+// checkCmd returns the "config check" command of the application.
+// This command is responsible for validating the config loaded from the file, env and flags and reporting each check
+func checkCmd(app *internal.App) *cobra.Command {
+	return &cobra.Command{
+		Use:   "check",
+		Short: "Check config",
+		// a failed check is not a usage error
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			res := app.CheckConfig()
+
+			failed := 0
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "CHECK\tRESULT\tDETAILS")
+			for _, r := range res {
+				switch {
+				case r.Skipped:
+					fmt.Fprintf(w, "%s\tSKIP\t\n", r.Name)
+				case r.Err != nil:
+					failed++
+					fmt.Fprintf(w, "%s\tFAIL\t%s\n", r.Name, r.Err.Error())
+				default:
+					fmt.Fprintf(w, "%s\tPASS\t%s\n", r.Name, r.Detail)
+				}
+			}
+
+			if err := w.Flush(); err != nil {
+				return err
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("%v of %v config checks failed", failed, len(res))
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,20 @@
+package config
+
+import (
+	"github.com/spf13/cobra"
+
+	"oracle-flare/internal"
+)
+
+// Cmd returns the "config" command of the application.
+// This command is a parent of the config related commands
+func Cmd(app *internal.App) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Config commands",
+	}
+
+	cmd.AddCommand(checkCmd(app))
+
+	return cmd
+}
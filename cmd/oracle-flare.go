@@ -1,6 +1,7 @@
 package main
 
 import (
+	"oracle-flare/cmd/config"
 	"oracle-flare/cmd/whitelist"
 	"oracle-flare/cmd/whitelistall"
 	"os"
@@ -28,6 +29,7 @@ func main() {
 	rootCmd.AddCommand(serve.Cmd(app))
 	rootCmd.AddCommand(whitelist.Cmd(app))
 	rootCmd.AddCommand(whitelistall.Cmd(app))
+	rootCmd.AddCommand(config.Cmd(app))
 
 	if err := rootCmd.Execute(); err != nil {
 		logger.Log().Infof("An error occurred: %s", err.Error())
@@ -23,6 +23,9 @@ func Cmd(app *internal.App) *cobra.Command {
 		},
 	}
 
+	cmd.PersistentFlags().String("network", "", fmt.Sprintf("network profile (%s). Flare config defaults are taken from it", strings.Join(config.NetworkNames(), ", ")))
+	cmd.PersistentFlags().String("config", "", "config file path (yaml, json or toml). It is watched and reloaded on change and on SIGHUP")
+
 	cmd.SetVersionTemplate(app.Version())
 
 	return cmd
@@ -31,6 +34,10 @@ func Cmd(app *internal.App) *cobra.Command {
 // initializeConfig reads in config file and sets configuration
 // via environment variables
 func initializeConfig(cmd *cobra.Command, cfg *config.Scheme) error {
+	if path, _ := cmd.Flags().GetString("config"); path != "" {
+		viper.SetConfigFile(path)
+	}
+
 	if err := viper.ReadInConfig(); err != nil {
 		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
 			return fmt.Errorf("read config file: %w", err)
@@ -42,6 +49,15 @@ func initializeConfig(cmd *cobra.Command, cfg *config.Scheme) error {
 	viper.AutomaticEnv()
 	viper.AllowEmptyEnv(true)
 
+	// the network profile only sets the defaults, so explicit flare values still override it
+	if network, _ := cmd.Flags().GetString("network"); network != "" {
+		viper.Set("network", network)
+	}
+
+	if err := config.SetNetworkDefaults(viper.GetString("network")); err != nil {
+		return err
+	}
+
 	bindFlags(cmd)
 
 	return viper.Unmarshal(cfg)
@@ -0,0 +1,64 @@
+package whitelist
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"oracle-flare/internal"
+	"oracle-flare/pkg/logger"
+)
+
+// checkCmd returns the "whitelist check" command of the application.
+// This command is responsible for reporting if the whitelisting tx for given address is worth sending
+func checkCmd(app *internal.App) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "Check whitelist status of the address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address, err := cmd.Flags().GetString("address")
+			if err != nil {
+				return fmt.Errorf("err get address flag: %w", err)
+			}
+
+			tokens, err := cmd.Flags().GetStringSlice("token")
+			if err != nil {
+				return fmt.Errorf("err get token flag: %w", err)
+			}
+
+			if err := app.InitForWhiteList(); err != nil {
+				return fmt.Errorf("application initialisation: %w", err)
+			}
+
+			res, err := app.CheckWhiteListAddress(address, tokens)
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "TOKEN\tWHITELISTED\tVOTERS\tMIN VOTE POWER\tVOTE POWER\tCHILLED UNTIL\tCAN BE WHITELISTED")
+			for _, r := range res {
+				if r.Err != nil {
+					fmt.Fprintf(w, "%s\terror: %s\n", r.Token, r.Err)
+					continue
+				}
+
+				fmt.Fprintf(
+					w, "%s\t%v\t%v/%v\t%v\t%v\t%v\t%v\n",
+					r.Token, r.Whitelisted, r.Voters, r.MaxVoters, r.MinVotePower, r.VotePower, r.ChilledUntil, r.CanBeWhitelisted(),
+				)
+			}
+
+			return w.Flush()
+		},
+		PreRun: func(cmd *cobra.Command, args []string) {
+			logger.Log().Info(app.Version())
+		},
+	}
+
+	cmd.Flags().String("address", "", "wallet address for whitelist check")
+	cmd.Flags().StringSlice("token", nil, "token symbols for whitelist check. Tokens from the config are used by default")
+
+	return cmd
+}
@@ -40,5 +40,7 @@ func Cmd(app *internal.App) *cobra.Command {
 	cmd.Flags().String("address", "", "wallet address for whitelist")
 	cmd.Flags().String("token", "", "token symbol for whitelist")
 
+	cmd.AddCommand(checkCmd(app))
+
 	return cmd
 }
@@ -4,26 +4,106 @@ import (
 	"github.com/spf13/viper"
 )
 
+// DefaultGasLimit is the default gas limit of the signer transactions. It is also used to estimate the cost per price
+// epoch if the gas limit is estimated by the rpc provider
+const DefaultGasLimit = 2000000
+
 // init initialize default config params
 func init() {
 	// environment - could be "local", "prod", "dev"
 	viper.SetDefault("env", "prod")
 
+	// debug, info, warning or error
+	viper.SetDefault("loglevel", "info")
+	// text or json
+	viper.SetDefault("logformat", "text")
+
 	// WS configurations
 	viper.SetDefault("ws.url", "wss://oracle.gateway.fm")
+	viper.SetDefault("ws.buffersize", 100)
+	// drop_oldest - the oldest message is dropped when the subscription buffer is full
+	// coalesce_latest - only the latest message per coin is buffered
+	viper.SetDefault("ws.overflowpolicy", "coalesce_latest")
+
+	// REST configurations. The rest price source is disabled if the url is empty
+	viper.SetDefault("rest.url", "")
+	viper.SetDefault("rest.valuepath", "")
+	viper.SetDefault("rest.pollintervalms", 10000)
+	viper.SetDefault("rest.quote", "USD")
+	viper.SetDefault("rest.standalone", false)
+
+	// Exchanges configurations. Direct exchange connectors are disabled if no names are given
+	viper.SetDefault("exchanges.names", []string{})
+	viper.SetDefault("exchanges.urls", []string{})
 
-	viper.SetDefault("flare.chainid", 114)
-	// 14 - flare chain mainnet
-	// 114 - coston2 chain testnet
-	// 19 - songbird chain net
+	// Conversion configurations. Prices quoted in USDT or USDC are converted to USD by our own USDT and USDC prices
+	viper.SetDefault("conversion.feeds", []string{"USDT=USDT", "USDC=USDC"})
+	viper.SetDefault("conversion.fixed", []string{})
+
+	// Validation configurations. Tokens violating the rules are withheld from the commit
+	viper.SetDefault("validation.maxchangeprevious", 0.2)
+	viper.SetDefault("validation.maxchangefinalized", 0.2)
+	viper.SetDefault("validation.minticks", 1)
+	viper.SetDefault("validation.maxrejected", 0)
+	viper.SetDefault("validation.maxwithheldepochs", 3)
+	viper.SetDefault("validation.tokenmaxwithheldepochs", []string{})
+
+	// Network profile - could be "mainnet", "coston2", "songbird", "coston" or "local". Flare chain ID, rpc url and
+	// registry address defaults are taken from it, see networks.go. There is no default network, so the chain is never
+	// chosen implicitly: without a network the flare values have to be set explicitly
+	viper.SetDefault("network", "")
+	viper.SetDefault("flare.chainid", 0)
+	viper.SetDefault("flare.rpcurl", "")
+	viper.SetDefault("flare.registrycontractaddress", "")
 
 	viper.SetDefault("tokens", []string{"BTC", "ETH"})
 
-	viper.SetDefault("flare.rpcurl", "https://flare-coston2.eu-north-2.gateway.fm/ext/bc/C/rpc")
+	// Data-provider profiles. The flare signer and the tokens are used as a single provider if it is empty
+	viper.SetDefault("providers", []map[string]interface{}{})
+
+	// Additional flare networks served with the same price sources
+	viper.SetDefault("chains", []map[string]interface{}{})
 
-	// Registry contract is a one address for every chain ID
-	viper.SetDefault("flare.registrycontractaddress", "0xaD67FE66660Fb8dFE9d6b1b4240d8650e30F6019")
+	// Optional ws rpc url for the new heads and events subscriptions
+	viper.SetDefault("flare.wsrpcurl", "")
+
+	// Token symbols naming rules and price submission protocol. Empty values are taken from the chain adapter
+	viper.SetDefault("flare.symbolprefix", "")
+	viper.SetDefault("flare.nativesymbol", "")
+	viper.SetDefault("flare.protocol", "")
 
 	// It is a wallet private key. Shall never be hardcoded
 	viper.SetDefault("flare.signerpk", "")
+	viper.SetDefault("flare.signingpolicykey", "")
+
+	// FTSOv2 Scaling configurations. The canonical feeds order has to be set explicitly for the ftsov2 protocol
+	viper.SetDefault("flare.scaling.feeds", []string{})
+	viper.SetDefault("flare.scaling.resulturl", "")
+	viper.SetDefault("flare.fastupdates.enabled", false)
+	viper.SetDefault("flare.fastupdates.sortitionkey", "")
+	viper.SetDefault("flare.fastupdates.feeds", []string{})
+	viper.SetDefault("flare.fastupdates.minchange", 0.0001)
+
+	// Gas settings of the signer transactions. 0 is estimated or suggested by the rpc provider
+	viper.SetDefault("flare.gaslimit", DefaultGasLimit)
+	viper.SetDefault("flare.gaspricegwei", 0)
+
+	// Alerts delivery. Webhooks can be set with the config file only
+	viper.SetDefault("alerts.webhooks", []map[string]interface{}{})
+	viper.SetDefault("alerts.dedupsec", 600)
+	viper.SetDefault("alerts.ratelimit", 10)
+
+	// Epoch lifecycle tracing: none, stdout or otlp
+	viper.SetDefault("tracing.exporter", "none")
+	viper.SetDefault("tracing.endpoint", "localhost:4318")
+	viper.SetDefault("tracing.insecure", false)
+	viper.SetDefault("tracing.sampleratio", 1)
+
+	// Prometheus metrics server address. The metrics are not served if it is empty
+	viper.SetDefault("metrics.addr", "")
+
+	// Signer balance monitoring. The low balance is warned and optionally the commits are paused before it runs out
+	viper.SetDefault("flare.balance.intervalsec", 60)
+	viper.SetDefault("flare.balance.warnepochs", 200)
+	viper.SetDefault("flare.balance.pauseepochs", 0)
 }
@@ -0,0 +1,107 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// flareRegistryAddress is the FlareContractRegistry address. It is the same for all public flare networks
+const flareRegistryAddress = "0xaD67FE66660Fb8dFE9d6b1b4240d8650e30F6019"
+
+// Network is a flare network profile. Its values are set as the flare config defaults, so each of them can still be
+// overridden explicitly by the config file, env or flags. The token symbols and protocol defaults are taken from the
+// chain adapter of the chain ID
+type Network struct {
+	// ChainID is the network chain ID
+	ChainID int
+	// RpcURL is the default rpc-provider url
+	RpcURL string
+	// RegistryContractAddress is the FlareContractRegistry address
+	RegistryContractAddress string
+}
+
+// Networks are the supported network profiles mapped by the name
+var Networks = map[string]*Network{
+	"mainnet": {
+		ChainID:                 14,
+		RpcURL:                  "https://flare-api.flare.network/ext/C/rpc",
+		RegistryContractAddress: flareRegistryAddress,
+	},
+	"coston2": {
+		ChainID:                 114,
+		RpcURL:                  "https://flare-coston2.eu-north-2.gateway.fm/ext/bc/C/rpc",
+		RegistryContractAddress: flareRegistryAddress,
+	},
+	"songbird": {
+		ChainID:                 19,
+		RpcURL:                  "https://songbird-api.flare.network/ext/C/rpc",
+		RegistryContractAddress: flareRegistryAddress,
+	},
+	"coston": {
+		ChainID:                 16,
+		RpcURL:                  "https://coston-api.flare.network/ext/C/rpc",
+		RegistryContractAddress: flareRegistryAddress,
+	},
+	// local is a local flare devnet. The registry address depends on the deployment, so it has to be set explicitly
+	"local": {
+		ChainID: 162,
+		RpcURL:  "http://127.0.0.1:9650/ext/bc/C/rpc",
+	},
+}
+
+// NetworkNames is used to get the sorted names of all supported networks
+func NetworkNames() []string {
+	names := make([]string, 0, len(Networks))
+	for n := range Networks {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// SetNetworkDefaults is used to set the flare config defaults from the named network profile. No defaults are set if
+// the name is empty
+func SetNetworkDefaults(name string) error {
+	if name == "" {
+		return nil
+	}
+
+	n, ok := Networks[strings.ToLower(name)]
+	if !ok {
+		return fmt.Errorf("network %s not supported, supported: %s", name, strings.Join(NetworkNames(), ", "))
+	}
+
+	viper.SetDefault("flare.chainid", n.ChainID)
+	viper.SetDefault("flare.rpcurl", n.RpcURL)
+	viper.SetDefault("flare.registrycontractaddress", n.RegistryContractAddress)
+
+	return nil
+}
+
+// CheckNetwork is used to validate the network name and the flare values it does not set. The chain ID, rpc url and
+// registry address have to be set explicitly if the network is empty, the registry address has to be set explicitly
+// for the networks without the registry default, e.g. "local"
+func CheckNetwork(name string, conf *Flare) error {
+	if name == "" {
+		if conf.ChainID == 0 || conf.RpcURL == "" || conf.RegistryContractAddress == "" {
+			return fmt.Errorf("no network is set: use --network or NETWORK (%s), or set flare.chainid, flare.rpcurl "+
+				"and flare.registrycontractaddress explicitly", strings.Join(NetworkNames(), ", "))
+		}
+
+		return nil
+	}
+
+	if _, ok := Networks[strings.ToLower(name)]; !ok {
+		return fmt.Errorf("network %s not supported, supported: %s", name, strings.Join(NetworkNames(), ", "))
+	}
+
+	if conf.RegistryContractAddress == "" {
+		return fmt.Errorf("network %s has no registry address default, flare.registrycontractaddress is required", name)
+	}
+
+	return nil
+}
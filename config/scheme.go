@@ -1,14 +1,147 @@
 package config
 
+import (
+	"fmt"
+	"strings"
+)
+
 // Scheme represents the application configuration scheme.
 type Scheme struct {
 	// Env is the application environment.
 	Env string
+	// Network is a flare network profile name. The flare config defaults are taken from it
+	Network string
+	// LogLevel is a min logged level, e.g. "debug", "info", "warning" or "error"
+	LogLevel string
+	// LogFormat is the log output format: "text" or "json"
+	LogFormat string
 
 	// Tokens is used for SendCoinAveragePrice method
+	Tokens     []string
+	WS         *WS
+	REST       *REST
+	Exchanges  *Exchanges
+	Conversion *Conversion
+	Validation *Validation
+	Flare      *Flare
+	// Providers are the data-provider profiles served by one process. A single provider with the flare signer and the
+	// tokens is used if it is empty
+	Providers []*Provider
+	// Chains are the additional flare networks served by one process with the shared price sources
+	Chains []*Chain
+	Alerts *Alerts
+	// Tracing is the epoch lifecycle tracing configs
+	Tracing *Tracing
+	// Metrics is the Prometheus metrics configs
+	Metrics *Metrics
+}
+
+// Provider is a data-provider profile. Each provider submits prices with its own signer and tokens, the price sources
+// and the rpc providers are shared
+type Provider struct {
+	// Name is the provider name used in the logs
+	Name string
+	// SignerPK is the provider signer private key. Shall never be hardcoded
+	SignerPK string
+	// SigningPolicyKey is the provider FTSOv2 signing policy private key. Shall never be hardcoded
+	SigningPolicyKey string
+	// Tokens are the provider tokens. The scheme tokens are used if it is empty
 	Tokens []string
-	WS     *WS
-	Flare  *Flare
+	// Source is an optional price source override: "ws", "exchanges" or "rest". The default price source is used if it
+	// is empty
+	Source string
+}
+
+// Chain is an additional flare network. Its flare config is the main flare config with the network profile values,
+// while the token symbols, protocol and fast updates are the chain defaults
+type Chain struct {
+	// Network is the network profile name, e.g. "songbird". It is used as the chain name in the logs
+	Network string
+	// RpcURL overrides the network profile rpc-provider url if it is set
+	RpcURL string
+	// WSRpcURL is an optional ws url for rpc-provider
+	WSRpcURL string
+	// RegistryContractAddress overrides the network profile registry address if it is set
+	RegistryContractAddress string
+	// Tokens are the tokens of the chain providers without own tokens. The scheme tokens are used if it is empty
+	Tokens []string
+	// Providers are the data-provider profiles of the chain. The scheme providers are used if it is empty
+	Providers []*Provider
+}
+
+// ProviderProfiles is used to get the data-provider profiles with the defaults filled. The flare signer and the scheme
+// tokens are used as a single unnamed provider if no providers are given
+func (s *Scheme) ProviderProfiles() []*Provider {
+	return providerProfiles(s.Providers, s.Flare, s.Tokens)
+}
+
+// ChainFlare is used to get the flare config of the additional chain
+func (s *Scheme) ChainFlare(c *Chain) (*Flare, error) {
+	n, ok := Networks[strings.ToLower(c.Network)]
+	if !ok {
+		return nil, fmt.Errorf("network %s not supported, supported: %s", c.Network, strings.Join(NetworkNames(), ", "))
+	}
+
+	conf := *s.Flare
+	conf.ChainID = n.ChainID
+	conf.RpcURL = n.RpcURL
+	conf.WSRpcURL = c.WSRpcURL
+	conf.RegistryContractAddress = n.RegistryContractAddress
+	conf.SymbolPrefix = ""
+	conf.NativeSymbol = ""
+	conf.Protocol = ""
+	conf.Scaling = nil
+	conf.FastUpdates = nil
+
+	if c.RpcURL != "" {
+		conf.RpcURL = c.RpcURL
+	}
+
+	if c.RegistryContractAddress != "" {
+		conf.RegistryContractAddress = c.RegistryContractAddress
+	}
+
+	if conf.RegistryContractAddress == "" {
+		return nil, fmt.Errorf("network %s has no registry address default, registrycontractaddress is required", c.Network)
+	}
+
+	return &conf, nil
+}
+
+// ChainProviderProfiles is used to get the data-provider profiles of the additional chain with the defaults filled.
+// The scheme providers are used if the chain has no providers
+func (s *Scheme) ChainProviderProfiles(c *Chain) []*Provider {
+	providers := c.Providers
+	if len(providers) == 0 {
+		providers = s.Providers
+	}
+
+	tokens := c.Tokens
+	if len(tokens) == 0 {
+		tokens = s.Tokens
+	}
+
+	return providerProfiles(providers, s.Flare, tokens)
+}
+
+// providerProfiles is used to get the copies of the providers with the tokens set. A single unnamed provider with the
+// flare signer and signing policy keys is used if no providers are given
+func providerProfiles(providers []*Provider, flare *Flare, tokens []string) []*Provider {
+	if len(providers) == 0 {
+		return []*Provider{{SignerPK: flare.SignerPK, SigningPolicyKey: flare.SigningPolicyKey, Tokens: tokens}}
+	}
+
+	profiles := make([]*Provider, len(providers))
+	for i, p := range providers {
+		provider := *p
+		if len(provider.Tokens) == 0 {
+			provider.Tokens = tokens
+		}
+
+		profiles[i] = &provider
+	}
+
+	return profiles
 }
 
 // Flare is a pkg-flare configs
@@ -17,14 +150,180 @@ type Flare struct {
 	RegistryContractAddress string
 	// RpcURL url for rpc-provider
 	RpcURL string
-	// ChainID for flare smart-contracts. Only 19 (songbird testnet), 16 (coston2 testnet) and 14 (flare mainnet) are supported
+	// WSRpcURL is an optional ws url for rpc-provider. It is used to subscribe to the new heads and smart-contract events.
+	// If it is not set and RpcURL is a ws url, RpcURL is used. Polling is used otherwise
+	WSRpcURL string
+	// ChainID for flare smart-contracts. Only the chains with the registered chain adapters are supported: 14 (flare
+	// mainnet), 114 (coston2 testnet), 19 (songbird), 16 (coston testnet) and 162 (local devnet)
 	ChainID int
+	// SymbolPrefix is prepended to the token names to get the FtsoRegistry symbols, e.g. "test" on the test-nets. The
+	// chain prefix is used if it is empty
+	SymbolPrefix string
+	// NativeSymbol is the FtsoRegistry symbol of the FLR token, e.g. "C2FLR" on coston2. The chain native symbol is
+	// used if it is empty
+	NativeSymbol string
 	// SignerPK is a wallet private key. Shall never be hardcoded
 	SignerPK string
+	// SigningPolicyKey is the FTSOv2 signing policy private key in hex, registered separately from the submit address.
+	// It signs the voting round results and the fast updates and its address holds the sortition weight. It is
+	// required for the FTSOv2 signing phase and fast updates. Shall never be hardcoded
+	SigningPolicyKey string
+	// Protocol is a price submission protocol. Only "ftsov1" (PriceSubmitter commit and reveal) and "ftsov2" (Flare
+	// Systems Protocol FTSOv2 Scaling) are supported. The chain default protocol is used if it is empty
+	Protocol string
+	// Scaling is the FTSOv2 Scaling protocol configs. It is used only with the "ftsov2" protocol
+	Scaling *Scaling
+	// FastUpdates is the FTSOv2 Fast Updates configs. It is used only with the "ftsov2" protocol
+	FastUpdates *FastUpdates
+	// GasLimit is a gas limit of the signer transactions. 0 means the limit is estimated by the rpc-provider
+	GasLimit uint64
+	// GasPriceGwei is a gas price of the signer transactions in gwei. 0 means the price is suggested by the rpc-provider
+	GasPriceGwei float64
+	// Balance is the signer balance monitoring configs
+	Balance *Balance
+}
+
+// Balance is a signer balance monitoring configs. Thresholds are the price epochs the balance is enough for at the
+// observed cost per epoch
+type Balance struct {
+	// IntervalSec is a balance check interval in seconds. 0 disables the monitoring
+	IntervalSec int
+	// WarnEpochs is a number of the remaining epochs below which the low balance is warned
+	WarnEpochs int
+	// PauseEpochs is a number of the remaining epochs below which the commits are paused, so the balance is left for
+	// the pending reveals. The reveals in flight still spend gas. 0 disables the pause
+	PauseEpochs int
+}
+
+// Scaling is a FTSOv2 Scaling protocol configs
+type Scaling struct {
+	// Feeds are the reward epoch canonical feeds order in the NAME=DECIMALS format, e.g. "BTC/USD=2". Feed values are
+	// revealed in this order, feeds without a token price are revealed empty
+	Feeds []string
+	// ResultURL is the FTSO scaling calculator voting round result url template, {votingRoundId} is replaced with the
+	// voting round id. The signing phase is skipped if it is empty
+	ResultURL string
+}
+
+// FastUpdates is a FTSOv2 Fast Updates configs
+type FastUpdates struct {
+	// Enabled is used to submit the fast updates when the signer is selected by the sortition
+	Enabled bool
+	// SortitionKey is the registered sortition private key in hex
+	SortitionKey string
+	// Feeds are the fast updates feed names in the FastUpdater indices order, e.g. "BTC/USD"
+	Feeds []string
+	// MinChange is a min relative difference between the price and the on-chain value to submit a delta, e.g. 0.0001
+	MinChange float64
+}
+
+// Alerts is a pkg alerts configs
+type Alerts struct {
+	// Webhooks are the alert delivery webhooks. Alerts are only logged if it is empty
+	Webhooks []*Webhook
+	// DedupSec is a window in seconds in which the alerts with the same event, source and key are sent once
+	DedupSec int
+	// RateLimit is a max number of the alerts sent to each webhook per minute. 0 means no limit
+	RateLimit int
+}
+
+// Tracing is a pkg tracing configs
+type Tracing struct {
+	// Exporter is the spans exporter. Only "none", "stdout" and "otlp" are supported
+	Exporter string
+	// Endpoint is the OTLP HTTP collector endpoint, e.g. "localhost:4318"
+	Endpoint string
+	// Insecure is used to export to the OTLP endpoint over plain HTTP
+	Insecure bool
+	// SampleRatio is a ratio of the sampled traces from 0 to 1
+	SampleRatio float64
+}
+
+// Metrics is a pkg metrics configs
+type Metrics struct {
+	// Addr is the metrics HTTP server address, e.g. ":9090". The metrics are not served if it is empty
+	Addr string
+}
+
+// Webhook is an alert delivery webhook configs
+type Webhook struct {
+	// Name is the webhook name used in the logs
+	Name string
+	// URL is the webhook url the payloads are posted to
+	URL string
+	// Format is the payload format. Only "json", "slack" and "pagerduty" are supported. It is not used if Template is set
+	Format string
+	// Template is an optional text/template of the JSON payload. See the pkg alerts payload for the fields
+	Template string
+	// RoutingKey is the PagerDuty integration routing key. It is used with the "pagerduty" format
+	RoutingKey string
+	// Events are the alert events sent to the webhook, e.g. "missed_reveal". All events are sent if it is empty
+	Events []string
 }
 
 // WS is a pkg ws client configs
 type WS struct {
 	// URL is a oracle url address
 	URL string
+	// BufferSize is a max number of messages buffered for each subscription consumer
+	BufferSize int
+	// OverflowPolicy is used when the subscription buffer is full. Only "drop_oldest" and "coalesce_latest" are supported
+	OverflowPolicy string
+}
+
+// REST is a pkg rest client configs. It is a polling price source used as the ws fallback or standalone
+type REST struct {
+	// URL is a price url template. {coin} and {coin_lower} are replaced with the coin symbol. The source is disabled
+	// if it is empty
+	URL string
+	// ValuePath is a dot separated path to the price value in the JSON response, e.g. "data.price" or "0.value"
+	ValuePath string
+	// PollIntervalMS is a prices poll interval in milliseconds
+	PollIntervalMS int
+	// Quote is a quote currency of the polled prices, e.g. "USD" or "USDT"
+	Quote string
+	// Standalone is true if the rest source is used instead of the ws. It is used as the ws fallback when the ws
+	// stream goes quiet otherwise
+	Standalone bool
+}
+
+// Exchanges is a pkg exchanges configs. Direct exchange connectors are used as the price source instead of the ws
+type Exchanges struct {
+	// Names are the exchanges to connect to. Only "binance", "coinbase" and "kraken" are supported. The exchanges
+	// source is disabled if it is empty
+	Names []string
+	// URLs are the optional exchanges public ws api urls overrides in the NAME=URL format, e.g.
+	// "binance=ws://localhost:8080". They are used to point the exchanges to a local server
+	URLs []string
+}
+
+// Conversion is a quote currencies to USD conversion configs
+type Conversion struct {
+	// Feeds are the quote currencies converted to USD by the latest USD price of the coin received from the price
+	// source in the QUOTE=COIN format, e.g. "USDT=USDT". Feed coins are subscribed in addition to the tokens
+	Feeds []string
+	// Fixed are the fixed quote currencies to USD rates in the QUOTE=RATE format, e.g. "USDT=1". They are used when
+	// there is no fresh feed price
+	Fixed []string
+}
+
+// Validation is a prices validation configs used before each commit
+type Validation struct {
+	// MaxChangePrevious is a max relative price change vs. the previous committed price, e.g. 0.2 for 20%. 0 disables
+	// the rule
+	MaxChangePrevious float64
+	// MaxChangeFinalized is a max relative price change vs. the last finalized FTSO price, e.g. 0.2 for 20%. 0 disables
+	// the rule
+	MaxChangeFinalized float64
+	// MinTicks is a min number of prices received for the token during the price epoch. 0 disables the rule
+	MinTicks int
+	// MaxRejected is a number of rejected tokens which trips the circuit breaker and withholds the whole commit.
+	// 0 disables the circuit breaker, so only rejected tokens are withheld
+	MaxRejected int
+	// MaxWithheldEpochs is a number of consecutive epochs a token is withheld by the previous price rule after which
+	// its new price level is accepted. 0 means never, the token is withheld until it is reset with SIGHUP
+	MaxWithheldEpochs int
+	// TokenMaxWithheldEpochs are the MaxWithheldEpochs of the tokens with own rule in the TOKEN=EPOCHS format, e.g.
+	// "BTC=0"
+	TokenMaxWithheldEpochs []string
 }
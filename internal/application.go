@@ -4,26 +4,47 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 
 	version "github.com/misnaged/annales/versioner"
 
 	"oracle-flare/config"
 	"oracle-flare/internal/service"
+	"oracle-flare/pkg/alerts"
+	"oracle-flare/pkg/exchanges"
 	"oracle-flare/pkg/flare"
+	"oracle-flare/pkg/logger"
+	"oracle-flare/pkg/metrics"
+	"oracle-flare/pkg/restClient"
+	"oracle-flare/pkg/tracing"
 	"oracle-flare/pkg/wsClient"
 )
 
 // App is main microservice application instance that
 // have all necessary dependencies inside structure
 type App struct {
-	// application configuration
-	config *config.Scheme
+	// application configuration. It is swapped on the reload, configMu guards the swap
+	configMu sync.RWMutex
+	config   *config.Scheme
 
-	ws      wsClient.IWSClient
-	fl      flare.IFlare
-	srv     service.IService
-	version *version.Version
+	ws   wsClient.IWSClient
+	ex   exchanges.IExchangesClient
+	rest restClient.IRestClient
+	// fl and srv are the flare and service of the whitelist commands
+	fl  flare.IFlare
+	srv service.IService
+	// chains are the served flare networks: the main one first and the additional chains
+	chains []*chain
+	// alerter is the alert webhooks client, nil if no webhooks are configured
+	alerter alerts.IAlerter
+	// stopTracing flushes the spans and stops the tracing exporter, nil until the tracing is initialized
+	stopTracing func()
+	// stopMetrics stops the metrics server, nil until the metrics are served
+	stopMetrics func()
+	// stopWatch stops the config file watcher, nil if the config file is not watched
+	stopWatch func()
+	version   *version.Version
 }
 
 // NewApplication create new App instance
@@ -39,20 +60,72 @@ func NewApplication() (app *App, err error) {
 	}, nil
 }
 
-// Init initialize application and all necessary instances
+// Init initialize application and all necessary instances. The config is validated with the same checks as on the
+// reload
 func (app *App) Init() error {
+	if err := checkConfig(app.config); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
 
-	app.ws = wsClient.NewClient(app.config.WS)
-	app.fl = flare.NewFlare(app.config.Flare)
-	app.srv = service.NewService(app.ws, app.fl)
+	if err := logger.SetLevel(app.config.LogLevel); err != nil {
+		return fmt.Errorf("log level: %w", err)
+	}
 
-	return nil
+	if err := logger.SetFormat(app.config.LogFormat); err != nil {
+		return fmt.Errorf("log format: %w", err)
+	}
+
+	if len(app.config.Alerts.Webhooks) > 0 {
+		alerter, err := alerts.NewAlerter(app.config.Alerts)
+		if err != nil {
+			return fmt.Errorf("alerts: %w", err)
+		}
+
+		app.alerter = alerter
+		alerts.SetAlerter(alerter)
+	}
+
+	stopTracing, err := tracing.Init(app.config.Tracing, app.Version())
+	if err != nil {
+		return fmt.Errorf("tracing: %w", err)
+	}
+	app.stopTracing = stopTracing
+
+	stopMetrics, err := metrics.Serve(app.config.Metrics)
+	if err != nil {
+		return fmt.Errorf("metrics: %w", err)
+	}
+	app.stopMetrics = stopMetrics
+
+	if app.config.Network == "" {
+		logWarn(fmt.Sprintf("no network is set, the explicit flare values are used: chain id: %v rpc: %s", app.config.Flare.ChainID, app.config.Flare.RpcURL), "Init")
+	} else {
+		logInfo(fmt.Sprintf("network: %s chain id: %v rpc: %s", app.config.Network, app.config.Flare.ChainID, app.config.Flare.RpcURL), "Init")
+	}
+
+	if app.config.REST.URL != "" {
+		app.rest = restClient.NewClient(app.config.REST)
+	}
+
+	// only one primary price source is used: the Index-deamon ws or the direct exchanges
+	switch {
+	case app.config.REST.Standalone:
+		if app.rest == nil {
+			return fmt.Errorf("rest price source is standalone but REST_URL is not set")
+		}
+	case len(app.config.Exchanges.Names) > 0:
+		app.ex = exchanges.NewClient(app.config.Exchanges)
+	default:
+		app.ws = wsClient.NewClient(app.config.WS)
+	}
+
+	return app.initChains()
 }
 
 // InitForWhiteList initialize application and all necessary instances for whitelist command
 func (app *App) InitForWhiteList() error {
 	app.fl = flare.NewFlare(app.config.Flare)
-	app.srv = service.NewService(nil, app.fl)
+	app.srv = service.NewService("", nil, nil, nil, app.fl, nil, nil, nil)
 
 	return nil
 }
@@ -81,18 +154,57 @@ func (app *App) WhiteListAddressAll(address string) error {
 	return nil
 }
 
+// CheckWhiteListAddress is used to run for whitelist check command. If no tokens are given, tokens from the config are used
+func (app *App) CheckWhiteListAddress(address string, tokens []string) ([]*service.WhitelistStatus, error) {
+	if len(tokens) == 0 {
+		tokens = app.config.Tokens
+	}
+
+	res, err := app.srv.CheckWhiteListAddress(address, tokens)
+	if err != nil {
+		app.Stop()
+		return nil, err
+	}
+
+	app.Stop()
+	return res, nil
+}
+
 // Serve start serving Application service
 func (app *App) Serve() error {
-	go app.srv.SendCoinAveragePrice(app.config.Tokens)
+	for _, p := range app.allProviders() {
+		go p.srv.SendCoinAveragePrice(p.conf.Tokens)
+	}
+
+	// config is reloaded on the config file change and on SIGHUP
+	changed := app.watchConfig()
+	reload := make(chan os.Signal, 1)
+	signal.Notify(reload, syscall.SIGHUP)
+
+	// SIGUSR1 is the manual reset of the tokens withheld by the previous price rule
+	resetWithheld := make(chan os.Signal, 1)
+	signal.Notify(resetWithheld, syscall.SIGUSR1)
 
 	// Gracefully shutdown the server
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
 
-	<-quit
-
-	app.Stop()
-	return nil
+	for {
+		select {
+		case <-changed:
+			app.reloadConfig()
+		case <-reload:
+			app.reloadConfig()
+		case <-resetWithheld:
+			logInfo("resetting withheld tokens...", "Serve")
+			for _, p := range app.allProviders() {
+				p.srv.ResetWithheld()
+			}
+		case <-quit:
+			app.Stop()
+			return nil
+		}
+	}
 }
 
 // Stop shutdown the application
@@ -106,16 +218,55 @@ func (app *App) Stop() {
 		app.fl.Close()
 	}
 
+	for _, c := range app.chains {
+		c.close()
+	}
+
 	if app.ws != nil {
 		app.ws.Close()
 	}
+
+	if app.ex != nil {
+		app.ex.Close()
+	}
+
+	if app.rest != nil {
+		app.rest.Close()
+	}
+
+	if app.alerter != nil {
+		app.alerter.Close()
+	}
+
+	if app.stopTracing != nil {
+		app.stopTracing()
+	}
+
+	if app.stopMetrics != nil {
+		app.stopMetrics()
+	}
+
+	if app.stopWatch != nil {
+		app.stopWatch()
+	}
 }
 
 // Config return App config Scheme
 func (app *App) Config() *config.Scheme {
+	app.configMu.RLock()
+	defer app.configMu.RUnlock()
+
 	return app.config
 }
 
+// setConfig is used to swap the App config Scheme
+func (app *App) setConfig(conf *config.Scheme) {
+	app.configMu.Lock()
+	defer app.configMu.Unlock()
+
+	app.config = conf
+}
+
 // Version return application current version
 func (app *App) Version() string {
 	return app.version.String()
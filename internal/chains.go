@@ -0,0 +1,117 @@
+package internal
+
+import (
+	"fmt"
+	"reflect"
+
+	"oracle-flare/config"
+	"oracle-flare/pkg/flare"
+)
+
+// chain is a served flare network. Each chain has its own rpc providers, and each of its providers has its own epoch
+// scheduler and contract set, while the price sources are shared by all chains
+type chain struct {
+	// name is the chain name used in the logs. It is empty if only the main chain is served
+	name      string
+	clients   *flare.Clients
+	providers []*provider
+}
+
+// initChains is used to create the main chain and the additional chains with their providers
+func (app *App) initChains() error {
+	mainProfiles := app.config.ProviderProfiles()
+	chainProfiles := make([][]*config.Provider, len(app.config.Chains))
+	chainConfs := make([]*config.Flare, len(app.config.Chains))
+
+	for i, c := range app.config.Chains {
+		conf, err := app.config.ChainFlare(c)
+		if err != nil {
+			return err
+		}
+
+		chainConfs[i] = conf
+		chainProfiles[i] = app.config.ChainProviderProfiles(c)
+	}
+
+	// price sources of all providers are created before any of them is started
+	for _, profiles := range append(chainProfiles, mainProfiles) {
+		for _, p := range profiles {
+			if err := app.initSource(p.Source); err != nil {
+				return fmt.Errorf("provider %s: %w", p.Name, err)
+			}
+		}
+	}
+
+	mainName := ""
+	if len(app.config.Chains) > 0 {
+		mainName = app.config.Network
+	}
+
+	app.chains = append(app.chains, app.newChain(mainName, app.config.Flare, mainProfiles))
+
+	for i, c := range app.config.Chains {
+		logInfo(fmt.Sprintf("network: %s chain id: %v rpc: %s", c.Network, chainConfs[i].ChainID, chainConfs[i].RpcURL), "Init")
+		app.chains = append(app.chains, app.newChain(c.Network, chainConfs[i], chainProfiles[i]))
+	}
+
+	return nil
+}
+
+// newChain is used to dial the chain rpc providers and create its providers
+func (app *App) newChain(name string, conf *config.Flare, profiles []*config.Provider) *chain {
+	clients := flare.NewClients(conf)
+
+	return &chain{
+		name:      name,
+		clients:   clients,
+		providers: app.newProviders(name, conf, clients, profiles),
+	}
+}
+
+// allProviders is used to get the providers of all chains
+func (app *App) allProviders() []*provider {
+	providers := []*provider{}
+	for _, c := range app.chains {
+		providers = append(providers, c.providers...)
+	}
+
+	return providers
+}
+
+// close is used to stop the chain providers and close its rpc providers
+func (c *chain) close() {
+	for _, p := range c.providers {
+		p.srv.Close()
+		p.fl.Close()
+	}
+
+	c.clients.Close()
+}
+
+// checkChains is used to validate the additional chains
+func checkChains(conf *config.Scheme) error {
+	for _, c := range conf.Chains {
+		if _, err := conf.ChainFlare(c); err != nil {
+			return err
+		}
+
+		if c.Network == conf.Network {
+			return fmt.Errorf("chain %s is the main network", c.Network)
+		}
+
+		if err := checkProviders(c.Providers); err != nil {
+			return fmt.Errorf("chain %s: %w", c.Network, err)
+		}
+
+		if err := checkTokens(c.Tokens); err != nil {
+			return fmt.Errorf("chain %s: %w", c.Network, err)
+		}
+	}
+
+	return nil
+}
+
+// chainsChanged is used to check the additional chains are changed
+func chainsChanged(old []*config.Chain, conf []*config.Chain) bool {
+	return !reflect.DeepEqual(old, conf)
+}
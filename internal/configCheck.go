@@ -0,0 +1,184 @@
+package internal
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"oracle-flare/config"
+	"oracle-flare/internal/service"
+	"oracle-flare/pkg/alerts"
+	"oracle-flare/pkg/exchanges"
+	"oracle-flare/pkg/flare"
+	"oracle-flare/pkg/flare/contracts/ftsov2"
+	"oracle-flare/pkg/logger"
+	"oracle-flare/pkg/metrics"
+	"oracle-flare/pkg/tracing"
+	"oracle-flare/pkg/wsClient"
+)
+
+// CheckConfig is used to run for config check command. The config values are validated first and then checked against
+// the price source and the blockchain
+func (app *App) CheckConfig() []*flare.CheckResult {
+	res := []*flare.CheckResult{{Name: "config values", Err: checkConfig(app.config)}}
+
+	switch {
+	case app.config.REST.Standalone || len(app.config.Exchanges.Names) > 0:
+		res = append(res, &flare.CheckResult{Name: "ws.url", Skipped: true})
+	default:
+		res = append(res, &flare.CheckResult{Name: "ws.url", Detail: app.config.WS.URL, Err: wsClient.CheckURL(app.config.WS.URL)})
+	}
+
+	// the chain is checked with the signer and tokens of the first provider, the other providers keys are validated
+	// with the config values
+	p := app.config.ProviderProfiles()[0]
+	flareConf := *app.config.Flare
+	flareConf.SignerPK = p.SignerPK
+
+	res = append(res, flare.CheckConfig(&flareConf, p.Tokens)...)
+
+	// additional chains are checked the same way, the results are prefixed with the network name
+	for _, c := range app.config.Chains {
+		chainConf, err := app.config.ChainFlare(c)
+		if err != nil {
+			res = append(res, &flare.CheckResult{Name: c.Network, Err: err})
+			continue
+		}
+
+		p := app.config.ChainProviderProfiles(c)[0]
+		chainConf.SignerPK = p.SignerPK
+
+		for _, r := range flare.CheckConfig(chainConf, p.Tokens) {
+			r.Name = fmt.Sprintf("%s: %s", c.Network, r.Name)
+			res = append(res, r)
+		}
+	}
+
+	return res
+}
+
+// checkConfig is used to validate the config values which can not be checked by its parsing
+func checkConfig(conf *config.Scheme) error {
+	if err := config.CheckNetwork(conf.Network, conf.Flare); err != nil {
+		return err
+	}
+
+	if _, err := logger.ParseLevel(conf.LogLevel); err != nil {
+		return fmt.Errorf("log level: %w", err)
+	}
+
+	if _, err := logger.ParseFormat(conf.LogFormat); err != nil {
+		return err
+	}
+
+	if err := tracing.CheckConfig(conf.Tracing); err != nil {
+		return err
+	}
+
+	if err := metrics.CheckConfig(conf.Metrics); err != nil {
+		return err
+	}
+
+	if len(conf.Tokens) == 0 {
+		return fmt.Errorf("no tokens")
+	}
+
+	if err := checkTokens(conf.Tokens); err != nil {
+		return err
+	}
+
+	if err := checkProviders(conf.Providers); err != nil {
+		return err
+	}
+
+	if err := checkChains(conf); err != nil {
+		return err
+	}
+
+	if _, err := wsClient.OverflowPolicyFromString(conf.WS.OverflowPolicy); err != nil {
+		return err
+	}
+
+	for _, n := range conf.Exchanges.Names {
+		if err := exchanges.CheckName(n); err != nil {
+			return err
+		}
+	}
+
+	if err := exchanges.CheckURLs(conf.Exchanges.URLs); err != nil {
+		return err
+	}
+
+	if err := service.CheckConversion(conf.Conversion); err != nil {
+		return err
+	}
+
+	if err := service.CheckValidation(conf.Validation); err != nil {
+		return err
+	}
+
+	protocol, err := flare.ConfigProtocol(conf.Flare)
+	if err != nil {
+		return err
+	}
+
+	if protocol == flare.FTSOv2 {
+		if conf.Flare.Scaling == nil || len(conf.Flare.Scaling.Feeds) == 0 {
+			return fmt.Errorf("no ftsov2 feeds order")
+		}
+
+		if _, err := ftsov2.ParseFeeds(conf.Flare.Scaling.Feeds); err != nil {
+			return fmt.Errorf("ftsov2 feeds: %w", err)
+		}
+
+		// the voting round results and the fast updates are signed with the signing policy key of each provider
+		fu := conf.Flare.FastUpdates
+		if conf.Flare.Scaling.ResultURL != "" || (fu != nil && fu.Enabled) {
+			for _, p := range conf.ProviderProfiles() {
+				if _, err := crypto.HexToECDSA(p.SigningPolicyKey); err != nil && p.Name == "" {
+					return fmt.Errorf("no valid ftsov2 signing policy private key")
+				} else if err != nil {
+					return fmt.Errorf("provider %s: no valid ftsov2 signing policy private key", p.Name)
+				}
+			}
+		}
+	}
+
+	if fu := conf.Flare.FastUpdates; fu != nil && fu.Enabled {
+		if protocol != flare.FTSOv2 {
+			return fmt.Errorf("fast updates are supported only with the ftsov2 protocol")
+		}
+
+		if len(fu.Feeds) == 0 {
+			return fmt.Errorf("no fast updates feeds")
+		}
+
+		for _, f := range fu.Feeds {
+			if _, err := ftsov2.NewFeedID(f); err != nil {
+				return fmt.Errorf("fast updates feeds: %w", err)
+			}
+		}
+
+		if _, err := ftsov2.NewSortitionKey(fu.SortitionKey); err != nil {
+			return fmt.Errorf("fast updates: %w", err)
+		}
+
+		if fu.MinChange < 0 {
+			return fmt.Errorf("fast updates min change can not be negative")
+		}
+	}
+
+	if conf.Flare.GasPriceGwei < 0 {
+		return fmt.Errorf("gas price can not be negative")
+	}
+
+	if b := conf.Flare.Balance; b != nil && (b.IntervalSec < 0 || b.WarnEpochs < 0 || b.PauseEpochs < 0) {
+		return fmt.Errorf("balance monitoring settings can not be negative")
+	}
+
+	if err := alerts.CheckConfig(conf.Alerts); err != nil {
+		return err
+	}
+
+	return nil
+}
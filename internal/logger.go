@@ -17,6 +17,6 @@ func logInfo(msg string, method string) {
 	logger.Log().WithField("layer", fmt.Sprintf("App-%s", method)).Info(msg)
 }
 
-//func logErr(msg string, method string) {
-//	logger.Log().WithField("layer", fmt.Sprintf("App-%s", method)).Error(msg)
-//}
+func logErr(msg string, method string) {
+	logger.Log().WithField("layer", fmt.Sprintf("App-%s", method)).Error(msg)
+}
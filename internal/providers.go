@@ -0,0 +1,151 @@
+package internal
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"oracle-flare/config"
+	"oracle-flare/internal/service"
+	"oracle-flare/pkg/exchanges"
+	"oracle-flare/pkg/flare"
+	"oracle-flare/pkg/flare/contracts"
+	"oracle-flare/pkg/wsClient"
+)
+
+// price sources of the provider Source override
+const (
+	sourceWS        = "ws"
+	sourceExchanges = "exchanges"
+	sourceREST      = "rest"
+)
+
+// provider is a served data-provider. Each provider has its own flare instance with the signer and its own service,
+// so nonces, pending reveals and logs are separated
+type provider struct {
+	conf *config.Provider
+	fl   flare.IFlare
+	srv  service.IService
+}
+
+// newProviders is used to create the flare instances and services of the data-provider profiles of the chain. The
+// price sources and the chain rpc providers are shared by them. Name is the chain name prefixed to the provider names
+// in the logs
+func (app *App) newProviders(name string, flareConf *config.Flare, clients *flare.Clients, profiles []*config.Provider) []*provider {
+	providers := make([]*provider, 0, len(profiles))
+
+	for _, p := range profiles {
+		conf := *flareConf
+		conf.SignerPK = p.SignerPK
+		conf.SigningPolicyKey = p.SigningPolicyKey
+
+		ws, ex := app.ws, app.ex
+		switch p.Source {
+		case sourceWS:
+			ex = nil
+		case sourceExchanges:
+			ws = nil
+		case sourceREST:
+			ws, ex = nil, nil
+		}
+
+		srvName := strings.Trim(fmt.Sprintf("%s-%s", name, p.Name), "-")
+
+		fl := flare.NewFlareWithClients(&conf, clients)
+		srv := service.NewService(srvName, ws, ex, app.rest, fl, app.config.Conversion, app.config.Validation, conf.FastUpdates)
+
+		if srvName != "" {
+			logInfo(fmt.Sprintf("provider %s signer: %s tokens: %v", srvName, fl.SignerAddress().Hex(), p.Tokens), "Init")
+		}
+
+		providers = append(providers, &provider{conf: p, fl: fl, srv: srv})
+	}
+
+	return providers
+}
+
+// initSource is used to create the price source of the provider Source override if it is not created yet
+func (app *App) initSource(source string) error {
+	switch source {
+	case "":
+	case sourceWS:
+		if app.ws == nil {
+			app.ws = wsClient.NewClient(app.config.WS)
+		}
+	case sourceExchanges:
+		if len(app.config.Exchanges.Names) == 0 {
+			return fmt.Errorf("exchanges price source is used but EXCHANGES_NAMES is not set")
+		}
+
+		if app.ex == nil {
+			app.ex = exchanges.NewClient(app.config.Exchanges)
+		}
+	case sourceREST:
+		if app.rest == nil {
+			return fmt.Errorf("rest price source is used but REST_URL is not set")
+		}
+	default:
+		return fmt.Errorf("price source %s not supported", source)
+	}
+
+	return nil
+}
+
+// checkProviders is used to validate the data-provider profiles
+func checkProviders(providers []*config.Provider) error {
+	names := make(map[string]struct{}, len(providers))
+
+	for _, p := range providers {
+		if p.Name == "" {
+			return fmt.Errorf("provider with no name")
+		}
+
+		if _, ok := names[p.Name]; ok {
+			return fmt.Errorf("provider %s is duplicated", p.Name)
+		}
+		names[p.Name] = struct{}{}
+
+		if _, err := crypto.HexToECDSA(p.SignerPK); err != nil {
+			return fmt.Errorf("provider %s: invalid signer private key", p.Name)
+		}
+
+		if p.SigningPolicyKey != "" {
+			if _, err := crypto.HexToECDSA(p.SigningPolicyKey); err != nil {
+				return fmt.Errorf("provider %s: invalid signing policy private key", p.Name)
+			}
+		}
+
+		if err := checkTokens(p.Tokens); err != nil {
+			return fmt.Errorf("provider %s: %w", p.Name, err)
+		}
+
+		switch p.Source {
+		case "", sourceWS, sourceExchanges, sourceREST:
+		default:
+			return fmt.Errorf("provider %s: price source %s not supported", p.Name, p.Source)
+		}
+	}
+
+	return nil
+}
+
+// checkTokens is used to check the token names are known
+func checkTokens(tokens []string) error {
+	for _, t := range tokens {
+		if contracts.GetTokenIDFromName(t) == contracts.UnknownToken {
+			return fmt.Errorf("unknown token %s", t)
+		}
+	}
+
+	return nil
+}
+
+// providersChanged is used to check the data-provider profiles are changed except of their tokens, which are applied
+// live
+func providersChanged(old []*config.Provider, conf []*config.Provider) bool {
+	return !slices.EqualFunc(old, conf, func(a *config.Provider, b *config.Provider) bool {
+		return a.Name == b.Name && a.SignerPK == b.SignerPK && a.Source == b.Source
+	})
+}
@@ -0,0 +1,293 @@
+package internal
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/viper"
+
+	"oracle-flare/config"
+	"oracle-flare/pkg/alerts"
+	"oracle-flare/pkg/logger"
+)
+
+// watchConfig is used to watch the config file and get the chanel notified on its changes. Notifications are merged
+// while the previous one is not handled. No notifications are sent if no config file is used. The watcher only
+// notifies: the file is read by the reload on the caller goroutine, so viper is never used concurrently
+func (app *App) watchConfig() chan struct{} {
+	changed := make(chan struct{}, 1)
+
+	file := viper.ConfigFileUsed()
+	if file == "" {
+		return changed
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		logErr(fmt.Sprintln("err create config watcher, config file is not watched:", err.Error()), "Watch")
+		return changed
+	}
+
+	// the dir is watched, so the file replaced by the editors or the mounted config maps is still watched
+	if err := watcher.Add(filepath.Dir(file)); err != nil {
+		logErr(fmt.Sprintln("err watch config dir, config file is not watched:", err.Error()), "Watch")
+		watcher.Close()
+		return changed
+	}
+
+	app.stopWatch = func() { watcher.Close() }
+
+	go func() {
+		for {
+			select {
+			case e, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+
+				if filepath.Clean(e.Name) != filepath.Clean(file) || !e.Has(fsnotify.Write) && !e.Has(fsnotify.Create) {
+					continue
+				}
+
+				select {
+				case changed <- struct{}{}:
+				default:
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+
+				logWarn(fmt.Sprintln("err watch config file:", err.Error()), "Watch")
+			}
+		}
+	}()
+
+	logInfo(fmt.Sprintln("watching config file", file), "Watch")
+
+	return changed
+}
+
+// reloadConfig is used to read and validate the config again and apply the changes which are safe to apply live.
+// Changes which need a restart are refused and the running values are kept
+func (app *App) reloadConfig() {
+	logInfo("reloading config...", "Reload")
+
+	conf, err := loadConfig()
+	if err != nil {
+		logErr(fmt.Sprintln("config is not changed:", err.Error()), "Reload")
+		return
+	}
+
+	if refused := app.restartRequired(conf); len(refused) > 0 {
+		logErr(fmt.Sprintf("changes of %s need a restart and are not applied", strings.Join(refused, ", ")), "Reload")
+	}
+
+	app.applyConfig(conf)
+}
+
+// loadConfig is used to read the config file and get the new validated config Scheme
+func loadConfig() (*config.Scheme, error) {
+	if err := viper.ReadInConfig(); err != nil {
+		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
+			return nil, fmt.Errorf("err read config file: %w", err)
+		}
+	}
+
+	if err := config.SetNetworkDefaults(viper.GetString("network")); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
+	conf := &config.Scheme{}
+	if err := viper.Unmarshal(conf); err != nil {
+		return nil, fmt.Errorf("err parse config: %w", err)
+	}
+
+	if err := checkConfig(conf); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
+	return conf, nil
+}
+
+// restartRequired is used to get the names of the changed config values which can not be applied live
+func (app *App) restartRequired(conf *config.Scheme) []string {
+	old := app.Config()
+	refused := []string{}
+
+	refuse := func(name string, changed bool) {
+		if changed {
+			refused = append(refused, name)
+		}
+	}
+
+	refuse("network", old.Network != conf.Network)
+	refuse("flare.chainid", old.Flare.ChainID != conf.Flare.ChainID)
+	refuse("flare.signerpk", old.Flare.SignerPK != conf.Flare.SignerPK)
+	refuse("providers", providersChanged(old.Providers, conf.Providers))
+	refuse("chains", chainsChanged(old.Chains, conf.Chains))
+	refuse("flare.rpcurl", old.Flare.RpcURL != conf.Flare.RpcURL)
+	refuse("flare.wsrpcurl", old.Flare.WSRpcURL != conf.Flare.WSRpcURL)
+	refuse("flare.registrycontractaddress", old.Flare.RegistryContractAddress != conf.Flare.RegistryContractAddress)
+	refuse("flare.symbolprefix", old.Flare.SymbolPrefix != conf.Flare.SymbolPrefix)
+	refuse("flare.nativesymbol", old.Flare.NativeSymbol != conf.Flare.NativeSymbol)
+	refuse("flare.protocol", old.Flare.Protocol != conf.Flare.Protocol)
+	refuse("flare.scaling", !reflect.DeepEqual(old.Flare.Scaling, conf.Flare.Scaling))
+	refuse("flare.fastupdates", !reflect.DeepEqual(old.Flare.FastUpdates, conf.Flare.FastUpdates))
+	refuse("ws.buffersize", old.WS.BufferSize != conf.WS.BufferSize)
+	refuse("ws.overflowpolicy", old.WS.OverflowPolicy != conf.WS.OverflowPolicy)
+	refuse("rest.standalone", old.REST.Standalone != conf.REST.Standalone)
+	// the price sources are chosen on start, so they can be changed but not enabled or disabled
+	refuse("rest.url", (old.REST.URL == "") != (conf.REST.URL == ""))
+	refuse("exchanges.names", (len(old.Exchanges.Names) == 0) != (len(conf.Exchanges.Names) == 0))
+	refuse("conversion", !reflect.DeepEqual(old.Conversion, conf.Conversion))
+	refuse("tracing", !reflect.DeepEqual(old.Tracing, conf.Tracing))
+	refuse("metrics", !reflect.DeepEqual(old.Metrics, conf.Metrics))
+
+	return refused
+}
+
+// applyConfig is used to apply the changed config values which are safe to apply live. The applied values are set to
+// the copy of the running config, which is swapped in when all of them are applied
+func (app *App) applyConfig(conf *config.Scheme) {
+	old := app.Config()
+	next := *old
+
+	if old.LogLevel != conf.LogLevel {
+		if err := logger.SetLevel(conf.LogLevel); err == nil {
+			logInfo(fmt.Sprintf("log level changed %s -> %s", old.LogLevel, conf.LogLevel), "Reload")
+			next.LogLevel = conf.LogLevel
+		}
+	}
+
+	if old.LogFormat != conf.LogFormat {
+		if err := logger.SetFormat(conf.LogFormat); err == nil {
+			logInfo(fmt.Sprintf("log format changed %s -> %s", old.LogFormat, conf.LogFormat), "Reload")
+			next.LogFormat = conf.LogFormat
+		}
+	}
+
+	// tokens of each provider are applied if the providers are not changed. The additional chains use the scheme
+	// providers and tokens by default, so their tokens are applied only if the chains are not changed too
+	if !providersChanged(old.Providers, conf.Providers) {
+		app.updateTokens(app.chains[0], conf.ProviderProfiles())
+
+		if !chainsChanged(old.Chains, conf.Chains) {
+			for i, c := range conf.Chains {
+				app.updateTokens(app.chains[i+1], conf.ChainProviderProfiles(c))
+			}
+		}
+
+		next.Tokens = conf.Tokens
+		next.Providers = conf.Providers
+	}
+
+	if app.ws != nil && old.WS.URL != conf.WS.URL {
+		ws := *old.WS
+		ws.URL = conf.WS.URL
+
+		next.WS = &ws
+		app.ws.SetURL(conf.WS.URL)
+	}
+
+	if app.rest != nil && conf.REST.URL != "" && !reflect.DeepEqual(old.REST, conf.REST) {
+		rest := *old.REST
+		rest.URL = conf.REST.URL
+		rest.ValuePath = conf.REST.ValuePath
+		rest.PollIntervalMS = conf.REST.PollIntervalMS
+		rest.Quote = conf.REST.Quote
+
+		next.REST = &rest
+		app.rest.UpdateConfig(next.REST)
+	}
+
+	if app.ex != nil && len(conf.Exchanges.Names) > 0 && (!slices.Equal(old.Exchanges.Names, conf.Exchanges.Names) || !slices.Equal(old.Exchanges.URLs, conf.Exchanges.URLs)) {
+		next.Exchanges = conf.Exchanges
+		app.ex.UpdateConfig(conf.Exchanges)
+		for _, p := range app.allProviders() {
+			p.srv.Resubscribe()
+		}
+	}
+
+	if old.Flare.GasLimit != conf.Flare.GasLimit || old.Flare.GasPriceGwei != conf.Flare.GasPriceGwei {
+		flareConf := *old.Flare
+		flareConf.GasLimit = conf.Flare.GasLimit
+		flareConf.GasPriceGwei = conf.Flare.GasPriceGwei
+
+		next.Flare = &flareConf
+		for _, p := range app.allProviders() {
+			p.fl.SetGas(conf.Flare.GasLimit, conf.Flare.GasPriceGwei)
+		}
+	}
+
+	if !reflect.DeepEqual(old.Flare.Balance, conf.Flare.Balance) {
+		flareConf := *next.Flare
+		flareConf.Balance = conf.Flare.Balance
+
+		next.Flare = &flareConf
+		for _, p := range app.allProviders() {
+			p.fl.SetBalance(conf.Flare.Balance)
+		}
+	}
+
+	if !reflect.DeepEqual(old.Alerts, conf.Alerts) && app.updateAlerter(conf.Alerts) {
+		next.Alerts = conf.Alerts
+	}
+
+	if !reflect.DeepEqual(old.Validation, conf.Validation) {
+		next.Validation = conf.Validation
+		for _, p := range app.allProviders() {
+			p.srv.UpdateValidation(conf.Validation)
+		}
+	}
+
+	app.setConfig(&next)
+
+	logInfo("config reloaded", "Reload")
+}
+
+// updateTokens is used to change the tokens of the chain providers to the tokens of the profiles
+func (app *App) updateTokens(c *chain, profiles []*config.Provider) {
+	for i, p := range profiles {
+		running := c.providers[i]
+		if !slices.Equal(running.conf.Tokens, p.Tokens) {
+			conf := *running.conf
+			conf.Tokens = p.Tokens
+
+			running.conf = &conf
+			running.srv.UpdateTokens(p.Tokens)
+		}
+	}
+}
+
+// updateAlerter is used to replace the alerter with the new one of the alerts configs. The running alerter is kept and
+// false is returned if the new one can not be created. The deduplication and rate limit state starts over with the
+// new alerter
+func (app *App) updateAlerter(conf *config.Alerts) bool {
+	var alerter alerts.IAlerter
+
+	if len(conf.Webhooks) > 0 {
+		a, err := alerts.NewAlerter(conf)
+		if err != nil {
+			logErr(fmt.Sprintln("err create alerter, alerts are not changed:", err.Error()), "Reload")
+			return false
+		}
+
+		alerter = a
+	}
+
+	alerts.SetAlerter(alerter)
+	if app.alerter != nil {
+		app.alerter.Close()
+	}
+
+	app.alerter = alerter
+
+	logInfo(fmt.Sprintf("alerts changed, webhooks: %v", len(conf.Webhooks)), "Reload")
+
+	return true
+}
@@ -2,96 +2,324 @@ package service
 
 import (
 	"crypto/rand"
+	"errors"
 	"fmt"
 	"math/big"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/sync/syncmap"
 
+	"oracle-flare/config"
+	"oracle-flare/pkg/alerts"
 	"oracle-flare/pkg/flare"
 	"oracle-flare/pkg/flare/contracts"
+	"oracle-flare/pkg/logger"
+	"oracle-flare/pkg/restClient"
 	"oracle-flare/pkg/wsClient"
 )
 
+// maxPriceAge is a max age of the price included in the commit
+const maxPriceAge = quietTimeout
+
 // SendCoinAveragePrice is used to subscribe on the avg price and send results to the flare smart contracts
 func (s *service) SendCoinAveragePrice(tokens []string) {
+	parsedTokens := parseTokens(tokens, s.method("SendCoinAveragePrice"))
+	if len(parsedTokens) == 0 {
+		logErr("all tokens are invalid", s.method("SendCoinAveragePrice"))
+		return
+	}
+
+	conv, err := newQuoteConverter(s.conversion)
+	if err != nil {
+		logErr(fmt.Sprintln("invalid conversion config:", err.Error()), s.method("SendCoinAveragePrice"))
+		return
+	}
+
+	s.sendersMu.Lock()
+	validator := newPriceValidator(s.name, s.validation, s.flare)
+	sender := newCoinAvgPriceSender(nextSenderID(), s.name, s.flare, s.priceSource(), s.restClient, conv, validator, parsedTokens)
+	s.avgPriceSenders = append(s.avgPriceSenders, sender)
+	s.sendersMu.Unlock()
+
+	go sender.runWriter()
+	go sender.runSender()
+
+	if s.fastUpdates != nil && s.fastUpdates.Enabled {
+		go sender.runFastUpdates(s.fastUpdates.MinChange)
+	}
+}
+
+// UpdateTokens is used to parse the tokens and change them in all running senders. The tokens are not changed if
+// all of them are invalid
+func (s *service) UpdateTokens(tokens []string) {
+	parsedTokens := parseTokens(tokens, s.method("UpdateTokens"))
+	if len(parsedTokens) == 0 {
+		logErr("all tokens are invalid, tokens are not changed", s.method("UpdateTokens"))
+		return
+	}
+
+	for _, sender := range s.senders() {
+		sender.updateTokens(parsedTokens)
+	}
+}
+
+// UpdateValidation is used to change the validation rules of the running senders and of the senders started later
+func (s *service) UpdateValidation(conf *config.Validation) {
+	s.sendersMu.Lock()
+	defer s.sendersMu.Unlock()
+
+	s.validation = conf
+
+	for _, sender := range s.avgPriceSenders {
+		sender.validator.updateConfig(conf)
+	}
+}
+
+// ResetWithheld is used to reset the withheld tokens of all running senders
+func (s *service) ResetWithheld() {
+	for _, sender := range s.senders() {
+		sender.validator.resetWithheld()
+	}
+}
+
+// Resubscribe is used to notify the writers of all running senders to subscribe the price sources again
+func (s *service) Resubscribe() {
+	for _, sender := range s.senders() {
+		sender.requestResubscribe()
+	}
+}
+
+// senders is used to get the running senders
+func (s *service) senders() []*coinAVGPriceSender {
+	s.sendersMu.RLock()
+	defer s.sendersMu.RUnlock()
+
+	return append([]*coinAVGPriceSender{}, s.avgPriceSenders...)
+}
+
+// parseTokens is used to get the token IDs from the token names. Unknown tokens are skipped
+func parseTokens(tokens []string, method string) []contracts.TokenID {
 	parsedTokens := []contracts.TokenID{}
 
 	for _, t := range tokens {
 		parsedToken := contracts.GetTokenIDFromName(t)
 		if parsedToken == contracts.UnknownToken {
-			logWarn(fmt.Sprintln("received unknown token:", t), "SendCoinAveragePrice")
+			logWarn(fmt.Sprintln("received unknown token:", t), method)
 		} else {
 			parsedTokens = append(parsedTokens, parsedToken)
 		}
 	}
 
-	if len(parsedTokens) == 0 {
-		logErr("all tokens are invalid", "SendCoinAveragePrice")
-		return
-	}
+	return parsedTokens
+}
 
-	sender := newCoinAvgPriceSender(len(s.avgPriceSenders), s.flare, s.wsClient, parsedTokens)
-	s.avgPriceSenders = append(s.avgPriceSenders, sender)
+// priceSource is a coin average price stream source, e.g. the ws client or direct exchanges
+type priceSource interface {
+	SubscribeCoinAveragePrice(coins []string, id int, frequencyMS int, v chan *wsClient.CoinAveragePriceStream) error
+	Unsubscribe(id int) error
+}
 
-	go sender.runWriter()
+// senderID is the last coin average price sender id. Senders of all services share the price sources, so their
+// subscription ids are unique in the process
+var senderID atomic.Int64
 
-	sender.ticker = time.NewTicker(time.Minute * 3)
-	logInfo("set ticker for sender to 3m", "SendCoinAveragePrice")
-	go sender.runSender()
+// nextSenderID is used to get new unique sender id
+func nextSenderID() int {
+	return int(senderID.Add(1) - 1)
 }
 
 // coinAVGPriceSender is a struct of the coin average prices sender
 type coinAVGPriceSender struct {
 	// id is a WS id
 	id int
+	// provider is the data-provider name. It is empty for the single provider
+	provider string
 
-	flare    flare.IFlare
-	wsClient wsClient.IWSClient
+	flare flare.IFlare
+	// source is the primary price source. The rest source is used standalone if it is nil
+	source     priceSource
+	restClient restClient.IRestClient
 
-	stream      chan *wsClient.CoinAveragePriceStream
-	stopWriter  chan struct{}
-	stopSender  chan struct{}
-	resubscribe chan struct{}
+	stream chan *wsClient.CoinAveragePriceStream
+	// restStream receives prices from the rest source
+	restStream chan *wsClient.CoinAveragePriceStream
+	stopWriter chan struct{}
+	stopSender chan struct{}
 
-	ticker *time.Ticker
+	// epochs receives price epoch data on each new price epoch
+	epochs chan *contracts.PriceEpochData
+	// submissions receives signer commit and reveal confirmations
+	submissions chan *contracts.SubmissionEvent
+	// committed are the epoch IDs with the confirmed commit
+	committed *syncmap.Map
 
-	// tokens are the tokens for each submit-reveal flow
+	// tokensMu guards the tokens
+	tokensMu sync.RWMutex
+	// tokens are the tokens for each submit-reveal flow. They can be changed at runtime
 	tokens []contracts.TokenID
-	// prices are the prices for next submit-reveal flow
+	// resubscribe is used to notify the writer that the tokens or the price sources are changed
+	resubscribe chan struct{}
+	// whitelisted are the tokens the signer is whitelisted for. Refreshed on each price epoch, nil until the first
+	// refresh
+	whitelisted atomic.Pointer[map[contracts.TokenID]bool]
+	// prices are the latest tokenPrice for next submit-reveal flow
 	prices *syncmap.Map
+	// audit are the USD conversion paths of the prices
+	audit *syncmap.Map
+	// conv converts the received prices to USD
+	conv *quoteConverter
+	// validator validates the prices before the commit
+	validator *priceValidator
 }
 
 // newCoinAvgPriceSender is used to get new coinAVGPriceSender instance
-func newCoinAvgPriceSender(id int, flare flare.IFlare, ws wsClient.IWSClient, tokens []contracts.TokenID) *coinAVGPriceSender {
+func newCoinAvgPriceSender(id int, provider string, flare flare.IFlare, source priceSource, rest restClient.IRestClient, conv *quoteConverter, validator *priceValidator, tokens []contracts.TokenID) *coinAVGPriceSender {
 	return &coinAVGPriceSender{
 		id:          id,
+		provider:    provider,
 		flare:       flare,
-		wsClient:    ws,
+		source:      source,
+		restClient:  rest,
 		stream:      make(chan *wsClient.CoinAveragePriceStream),
+		restStream:  make(chan *wsClient.CoinAveragePriceStream),
 		stopWriter:  make(chan struct{}),
 		stopSender:  make(chan struct{}),
-		resubscribe: make(chan struct{}),
+		epochs:      flare.SubscribePriceEpochs(),
+		submissions: flare.SubscribeSubmissions(),
+		committed:   &syncmap.Map{},
 		tokens:      tokens,
+		resubscribe: make(chan struct{}, 1),
 		prices:      &syncmap.Map{},
+		audit:       &syncmap.Map{},
+		conv:        conv,
+		validator:   validator,
 	}
 }
 
-// parsePrices is used to get big int prices from the prices map
-func (s *coinAVGPriceSender) parsePrices() (prices []*big.Int) {
-	for _, t := range s.tokens {
-		p := big.NewInt(0)
-		pp, ok := s.prices.Load(t)
-		if ok {
-			pb, ok := pp.(*big.Int)
-			if ok {
-				p = pb
+// method is used to get the log method name of the sender provider
+func (s *coinAVGPriceSender) method(name string) string {
+	return providerMethod(s.provider, name)
+}
+
+// tokenPrice is the latest token price in USD with priceDecimals
+type tokenPrice struct {
+	value *big.Int
+	at    time.Time
+}
+
+// getTokens is used to get the current tokens
+func (s *coinAVGPriceSender) getTokens() []contracts.TokenID {
+	s.tokensMu.RLock()
+	defer s.tokensMu.RUnlock()
+
+	return append([]contracts.TokenID{}, s.tokens...)
+}
+
+// updateTokens is used to change the tokens and notify the writer to resubscribe the price sources
+func (s *coinAVGPriceSender) updateTokens(tokens []contracts.TokenID) {
+	s.tokensMu.Lock()
+	logInfo(fmt.Sprintf("tokens changed %v -> %v", s.tokens, tokens), s.method("UpdateTokens"))
+	s.tokens = tokens
+	s.tokensMu.Unlock()
+
+	s.requestResubscribe()
+
+	go s.refreshWhitelist()
+}
+
+// requestResubscribe is used to notify the writer to resubscribe the price sources. Pending requests are merged
+func (s *coinAVGPriceSender) requestResubscribe() {
+	select {
+	case s.resubscribe <- struct{}{}:
+	default:
+	}
+}
+
+// refreshWhitelist is used to get the tokens the signer is whitelisted for. The previous token state is kept on the
+// token errors
+func (s *coinAVGPriceSender) refreshWhitelist() {
+	signer := s.flare.SignerAddress()
+	whitelisted := make(map[contracts.TokenID]bool)
+
+	var previous map[contracts.TokenID]bool
+	if p := s.whitelisted.Load(); p != nil {
+		previous = *p
+	}
+
+	for _, t := range s.getTokens() {
+		providers, err := s.flare.GetFtsoWhitelistedPriceProviders(t)
+		// there is no whitelist in the protocol, all tokens are submitted
+		if errors.Is(err, flare.ErrNotSupported) {
+			s.whitelisted.Store(nil)
+			return
+		}
+
+		if err != nil {
+			logWarn(fmt.Sprintf("err get %s whitelisted providers: %s", t.Name(), err.Error()), s.method("Whitelist"), chainFields(s.flare, nil).With(logger.FieldToken, t.Name()))
+
+			if ok, known := previous[t]; known {
+				whitelisted[t] = ok
+			}
+			continue
+		}
+
+		whitelisted[t] = false
+		for _, p := range providers {
+			if p == signer {
+				whitelisted[t] = true
+				break
 			}
 		}
-		prices = append(prices, p)
+
+		if !whitelisted[t] && previous[t] {
+			logAlert(alerts.WhitelistRemoved, t.Name(), fmt.Sprintf("signer %s is removed from the %s whitelist, token is not submitted", signer.Hex(), t.Name()), s.method("Whitelist"), chainFields(s.flare, nil).With(logger.FieldToken, t.Name()))
+		} else if !whitelisted[t] {
+			logWarn(fmt.Sprintf("signer %s is not whitelisted for %s, token is not submitted", signer.Hex(), t.Name()), s.method("Whitelist"), chainFields(s.flare, nil).With(logger.FieldToken, t.Name()))
+		}
 	}
 
-	return prices
+	s.whitelisted.Store(&whitelisted)
+}
+
+// commitPrices is used to get the tokens and prices to commit. Only whitelisted tokens supported by the chain with a
+// fresh price are included. All tokens are treated as whitelisted until the whitelist is loaded
+func (s *coinAVGPriceSender) commitPrices() ([]contracts.TokenID, []*big.Int) {
+	whitelisted := s.whitelisted.Load()
+
+	tokens := []contracts.TokenID{}
+	prices := []*big.Int{}
+
+	for _, t := range s.getTokens() {
+		if !s.flare.IsTokenSupported(t) {
+			logWarn(fmt.Sprintf("%s is not supported by the chain, token is not submitted", t.Name()), s.method("Sender"), chainFields(s.flare, nil).With(logger.FieldToken, t.Name()))
+			continue
+		}
+
+		if whitelisted != nil {
+			if ok, known := (*whitelisted)[t]; known && !ok {
+				continue
+			}
+		}
+
+		pp, ok := s.prices.Load(t)
+		if !ok {
+			logWarn(fmt.Sprintf("no %s price, token is not submitted", t.Name()), s.method("Sender"), chainFields(s.flare, nil).With(logger.FieldToken, t.Name()))
+			continue
+		}
+
+		p := pp.(*tokenPrice)
+		if age := time.Since(p.at); age > maxPriceAge {
+			logWarn(fmt.Sprintf("%s price is %v old, token is not submitted", t.Name(), age.Round(time.Second)), s.method("Sender"), chainFields(s.flare, nil).With(logger.FieldToken, t.Name()))
+			continue
+		}
+
+		tokens = append(tokens, t)
+		prices = append(prices, p.value)
+	}
+
+	return tokens, prices
 }
 
 // getRandom is used to update random arg and return it
@@ -108,15 +336,57 @@ func (s *coinAVGPriceSender) getRandom() *big.Int {
 func (s *coinAVGPriceSender) tokenNames() []string {
 	tokenNames := []string{}
 
-	for _, t := range s.tokens {
+	for _, t := range s.getTokens() {
 		tokenNames = append(tokenNames, t.Name())
 	}
 
 	return tokenNames
 }
 
+// sourceCoins is used to get the coins subscribed from the price source. Conversion feed coins are first, so the
+// conversion rates are received before the prices to convert
+func (s *coinAVGPriceSender) sourceCoins() []string {
+	coins := []string{}
+	seen := make(map[string]struct{})
+
+	for _, c := range append(s.conv.feedCoins(), s.tokenNames()...) {
+		if _, ok := seen[c]; ok {
+			continue
+		}
+		seen[c] = struct{}{}
+		coins = append(coins, c)
+	}
+
+	return coins
+}
+
+// auditPrices is used to log the USD conversion paths of the prices
+func (s *coinAVGPriceSender) auditPrices(epochID *big.Int) {
+	for _, t := range s.getTokens() {
+		path, ok := s.audit.Load(t)
+		if !ok {
+			path = "no price"
+		}
+
+		logInfo(fmt.Sprintf("epochID: %v %s price: %v", epochID, t.Name(), path), s.method("Audit"), chainFields(s.flare, epochID).With(logger.FieldToken, t.Name()))
+	}
+}
+
 // close is used to close coin average price sender
 func (s *coinAVGPriceSender) close() {
+	if s.source != nil {
+		if err := s.source.Unsubscribe(s.id); err != nil {
+			logWarn(fmt.Sprintln("err unsubscribe:", err.Error()), s.method("Close"))
+		}
+	}
+
+	if s.restClient != nil {
+		// there is no rest subscription while the primary stream is alive
+		if err := s.restClient.Unsubscribe(s.id); err != nil {
+			logDebug(fmt.Sprintln("err unsubscribe rest:", err.Error()), s.method("Close"))
+		}
+	}
+
 	close(s.stopWriter)
 	close(s.stopSender)
 }
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"oracle-flare/pkg/flare"
+	"oracle-flare/pkg/flare/contracts"
+)
+
+// submission is the tokens and prices of a commit or reveal
+type submission struct {
+	tokens []contracts.TokenID
+	prices []*big.Int
+}
+
+// ftsov2Flare is a FTSOv2 flare stub: the tokens are supported by the feeds and there are no FTSO indices
+type ftsov2Flare struct {
+	flare.IFlare
+	feeds     map[contracts.TokenID]bool
+	commits   chan submission
+	reveals   chan submission
+	submitted chan *contracts.SubmissionEvent
+}
+
+func (f *ftsov2Flare) ChainID() int { return 114 }
+
+func (f *ftsov2Flare) IsTokenSupported(index contracts.TokenID) bool { return f.feeds[index] }
+
+func (f *ftsov2Flare) SubscribePriceEpochs() chan *contracts.PriceEpochData {
+	return make(chan *contracts.PriceEpochData)
+}
+
+func (f *ftsov2Flare) SubscribeSubmissions() chan *contracts.SubmissionEvent { return f.submitted }
+
+func (f *ftsov2Flare) CommitPrices(_ context.Context, _ *big.Int, indices []contracts.TokenID, prices []*big.Int, _ *big.Int) error {
+	f.commits <- submission{tokens: indices, prices: prices}
+	return nil
+}
+
+func (f *ftsov2Flare) RevealPrices(_ context.Context, _ *big.Int, indices []contracts.TokenID, prices []*big.Int, _ *big.Int) error {
+	f.reveals <- submission{tokens: indices, prices: prices}
+	return nil
+}
+
+func TestCommitFTSOv2(t *testing.T) {
+	fl := &ftsov2Flare{
+		feeds:     map[contracts.TokenID]bool{contracts.BTC: true, contracts.ETH: true},
+		commits:   make(chan submission, 1),
+		reveals:   make(chan submission, 1),
+		submitted: make(chan *contracts.SubmissionEvent),
+	}
+
+	tokens := []contracts.TokenID{contracts.BTC, contracts.ETH, contracts.XRP}
+	s := newCoinAvgPriceSender(0, "", fl, nil, nil, nil, newPriceValidator("", nil, fl), tokens)
+
+	now := time.Now()
+	for i, token := range tokens {
+		s.prices.Store(token, &tokenPrice{value: big.NewInt(int64(i + 1)), at: now})
+	}
+
+	epoch := &contracts.PriceEpochData{EpochID: big.NewInt(10)}
+	s.commit(context.Background(), time.NewTimer(0), now, now, epoch)
+
+	// XRP has no feed, so it is not submitted
+	want := submission{
+		tokens: []contracts.TokenID{contracts.BTC, contracts.ETH},
+		prices: []*big.Int{big.NewInt(1), big.NewInt(2)},
+	}
+
+	for name, ch := range map[string]chan submission{"commit": fl.commits, "reveal": fl.reveals} {
+		select {
+		case got := <-ch:
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%s: got %+v, want %+v", name, got, want)
+			}
+		default:
+			t.Errorf("no %s", name)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"oracle-flare/config"
+)
+
+const (
+	// usdQuote is a quote currency of the submitted prices
+	usdQuote = "USD"
+	// maxRateAge is a max age of the feed conversion rate. Fixed rate is used for the older ones
+	maxRateAge = quietTimeout
+)
+
+// conversion is a quote currency to USD conversion
+type conversion struct {
+	rate float64
+	// path is the conversion path for audit
+	path string
+	at   time.Time
+}
+
+// quoteConverter is used to convert prices in the arbitrary quote currencies to USD. Feed rates are taken from the
+// directly USD quoted prices of the feed coins received from the price source, so conversions are never chained
+type quoteConverter struct {
+	// feeds mapping quote currency to the coin used as the conversion feed
+	feeds map[string]string
+	// fixed mapping quote currency to the fixed conversion rate
+	fixed map[string]float64
+
+	mu sync.Mutex
+	// rates mapping quote currency to the latest feed conversion
+	rates map[string]*conversion
+}
+
+// newQuoteConverter is used to get new quoteConverter instance from the config. Nil config means no conversions
+func newQuoteConverter(conf *config.Conversion) (*quoteConverter, error) {
+	q := &quoteConverter{
+		feeds: make(map[string]string),
+		fixed: make(map[string]float64),
+		rates: make(map[string]*conversion),
+	}
+
+	if conf == nil {
+		return q, nil
+	}
+
+	for _, f := range conf.Feeds {
+		quote, coin, err := splitConversion(f)
+		if err != nil {
+			return nil, fmt.Errorf("conversion feed: %w", err)
+		}
+		q.feeds[quote] = coin
+	}
+
+	for _, f := range conf.Fixed {
+		quote, rateS, err := splitConversion(f)
+		if err != nil {
+			return nil, fmt.Errorf("conversion fixed rate: %w", err)
+		}
+
+		rate, err := strconv.ParseFloat(rateS, 64)
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("conversion fixed rate: invalid rate %s", f)
+		}
+		q.fixed[quote] = rate
+	}
+
+	return q, nil
+}
+
+// CheckConversion is used to check the conversion config
+func CheckConversion(conf *config.Conversion) error {
+	_, err := newQuoteConverter(conf)
+	return err
+}
+
+// splitConversion is used to split the KEY=VALUE conversion config value
+func splitConversion(s string) (string, string, error) {
+	k, v, ok := strings.Cut(s, "=")
+	if !ok || k == "" || v == "" {
+		return "", "", fmt.Errorf("invalid value %s, KEY=VALUE expected", s)
+	}
+
+	return strings.ToUpper(k), strings.ToUpper(v), nil
+}
+
+// feedCoins is used to get all coins used as the conversion feeds
+func (q *quoteConverter) feedCoins() []string {
+	coins := make([]string, 0, len(q.feeds))
+	for _, c := range q.feeds {
+		coins = append(coins, c)
+	}
+
+	return coins
+}
+
+// toUSD is used to convert the coin value in the quote currency to USD. Returns the USD value and the conversion path
+func (q *quoteConverter) toUSD(coin string, quote string, value float64) (float64, string, error) {
+	quote = strings.ToUpper(quote)
+	if isUSD(quote) {
+		return value, fmt.Sprintf("%s/USD %v", coin, value), nil
+	}
+
+	if quote == coin {
+		return 0, "", fmt.Errorf("%s price is quoted in itself", coin)
+	}
+
+	c, err := q.rate(quote)
+	if err != nil {
+		return 0, "", err
+	}
+
+	usd := value * c.rate
+	return usd, fmt.Sprintf("%s/%s %v x [%s] = %s/USD %v", coin, quote, value, c.path, coin, usd), nil
+}
+
+// isUSD is used to check if the quote currency is USD. Empty quote is USD
+func isUSD(quote string) bool {
+	return quote == "" || strings.EqualFold(quote, usdQuote)
+}
+
+// rate is used to get the quote currency conversion. The fresh feed rate is preferred over the fixed one
+func (q *quoteConverter) rate(quote string) (*conversion, error) {
+	q.mu.Lock()
+	c, ok := q.rates[quote]
+	q.mu.Unlock()
+
+	if ok && time.Since(c.at) <= maxRateAge {
+		return c, nil
+	}
+
+	if rate, ok := q.fixed[quote]; ok {
+		return &conversion{rate: rate, path: fmt.Sprintf("%s/USD %v fixed", quote, rate)}, nil
+	}
+
+	if ok {
+		return nil, fmt.Errorf("%s conversion rate is stale: %v old", quote, time.Since(c.at).Round(time.Second))
+	}
+
+	return nil, fmt.Errorf("no %s conversion rate", quote)
+}
+
+// observe is used to update the conversion rates fed by the coin with its directly USD quoted price
+func (q *quoteConverter) observe(coin string, usd float64) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for quote, feed := range q.feeds {
+		if feed == coin && usd > 0 {
+			q.rates[quote] = &conversion{rate: usd, path: fmt.Sprintf("%s/USD %v feed %s", quote, usd, feed), at: time.Now()}
+		}
+	}
+}
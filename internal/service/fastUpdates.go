@@ -0,0 +1,116 @@
+package service
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"oracle-flare/pkg/flare/contracts"
+)
+
+// runFastUpdates is used to submit the FTSOv2 fast updates in each block the signer is selected in by the sortition.
+// The deltas move the on-chain feed values towards the latest prices of the sender
+func (s *coinAVGPriceSender) runFastUpdates(minChange float64) {
+	blocks := s.flare.SubscribeBlocks()
+	logInfo(fmt.Sprintf("fast updates started, min change: %v", minChange), s.method("FastUpdates"), chainFields(s.flare, nil))
+
+	for {
+		select {
+		case <-s.stopSender:
+			return
+		case block := <-blocks:
+			s.submitFastUpdates(block, minChange)
+		}
+	}
+}
+
+// submitFastUpdates is used to check the signer sortition in the block and submit the deltas if it is selected
+func (s *coinAVGPriceSender) submitFastUpdates(block *big.Int, minChange float64) {
+	// fast updates are paused with the commits on the low signer balance
+	if status := s.flare.BalanceStatus(); status != nil && status.Paused {
+		return
+	}
+
+	updater, err := s.flare.FastUpdater()
+	if err != nil {
+		logErr(fmt.Sprintln("err get fast updater:", err.Error()), s.method("FastUpdates"), chainFields(s.flare, nil))
+		return
+	}
+
+	credential, err := updater.GetSortitionCredential(block)
+	if err != nil {
+		logWarn(fmt.Sprintf("block: %v err get sortition credential: %s", block, err.Error()), s.method("FastUpdates"), chainFields(s.flare, nil))
+		return
+	}
+
+	if credential == nil {
+		return
+	}
+
+	feeds, err := updater.GetCurrentFeeds()
+	if err != nil {
+		logWarn(fmt.Sprintf("block: %v err get feeds: %s", block, err.Error()), s.method("FastUpdates"), chainFields(s.flare, nil))
+		return
+	}
+
+	deltas := s.fastUpdateDeltas(feeds, minChange)
+	if deltas == nil {
+		logDebug(fmt.Sprintf("block: %v selected, no deltas to submit", block), s.method("FastUpdates"), chainFields(s.flare, nil))
+		return
+	}
+
+	logInfo(fmt.Sprintf("block: %v selected with replicate %v, deltas: %v", block, credential.Replicate, deltas), s.method("FastUpdates"), chainFields(s.flare, nil))
+
+	if err := updater.SubmitUpdates(&contracts.FastUpdate{SortitionBlock: block, Credential: credential, Deltas: deltas}); err != nil {
+		logErr(fmt.Sprintf("block: %v err submit updates: %s", block, err.Error()), s.method("FastUpdates"), chainFields(s.flare, nil))
+	}
+}
+
+// fastUpdateDeltas is used to get the deltas of the feeds: 1 if the price is above the on-chain value by more than
+// minChange, -1 if it is below and 0 otherwise. Feeds without a fresh token price are not changed. Returns nil if all
+// deltas are 0
+func (s *coinAVGPriceSender) fastUpdateDeltas(feeds []*contracts.FastUpdateFeed, minChange float64) []int8 {
+	tokens := make(map[contracts.TokenID]struct{})
+	for _, t := range s.getTokens() {
+		tokens[t] = struct{}{}
+	}
+
+	deltas := make([]int8, len(feeds))
+	changed := false
+
+	for i, f := range feeds {
+		if _, ok := tokens[f.Token]; !ok || f.Value.Sign() <= 0 {
+			continue
+		}
+
+		pp, ok := s.prices.Load(f.Token)
+		if !ok {
+			continue
+		}
+
+		p := pp.(*tokenPrice)
+		if time.Since(p.at) > maxPriceAge {
+			continue
+		}
+
+		current := toFloat(f.Value, f.Decimals)
+		value := toFloat(p.value, priceDecimals)
+
+		switch {
+		case value > current*(1+minChange):
+			deltas[i] = 1
+		case value < current*(1-minChange):
+			deltas[i] = -1
+		default:
+			continue
+		}
+
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+
+	return deltas
+}
@@ -2,7 +2,10 @@ package service
 
 import (
 	"fmt"
+	"math/big"
 
+	"oracle-flare/pkg/alerts"
+	"oracle-flare/pkg/flare"
 	"oracle-flare/pkg/logger"
 )
 
@@ -10,22 +13,50 @@ import (
 //	logger.Log().WithField("layer", fmt.Sprintf("Service-%s", method)).Fatal(msg)
 //}
 
-func logWarn(msg string, method string) {
-	logger.Log().WithField("layer", fmt.Sprintf("Service-%s", method)).Warning(msg)
+func logWarn(msg string, method string, fields ...logger.Fields) {
+	logger.WithLayer(fmt.Sprintf("Service-%s", method), fields...).Warning(msg)
 }
 
-func logInfo(msg string, method string) {
-	logger.Log().WithField("layer", fmt.Sprintf("Service-%s", method)).Info(msg)
+// logAlert is used to log the events which need the operator attention and send them to the alert webhooks. Key is
+// the alert subject, e.g. the token name, the alerts with the same event, layer and key are deduplicated
+func logAlert(event alerts.Event, key string, msg string, method string, fields ...logger.Fields) {
+	layer := fmt.Sprintf("Service-%s", method)
+	logger.WithLayer(layer, fields...).WithField("alert", event.String()).Error(msg)
+	alerts.Notify(event, layer, key, msg)
 }
 
-func logErr(msg string, method string) {
-	logger.Log().WithField("layer", fmt.Sprintf("Service-%s", method)).Error(msg)
+// providerMethod is used to get the log method name prefixed with the data-provider name, so the logs of the providers
+// served by one process are separated. The name is not prefixed for the single unnamed provider
+func providerMethod(provider string, method string) string {
+	if provider == "" {
+		return method
+	}
+
+	return fmt.Sprintf("%s-%s", provider, method)
+}
+
+// chainFields is used to get the log fields of the flare chain. The epoch ID is added if it is not nil
+func chainFields(fl flare.IFlare, epochID *big.Int) logger.Fields {
+	fields := logger.Fields{logger.FieldChainID: fl.ChainID()}
+	if epochID != nil {
+		fields[logger.FieldEpochID] = epochID
+	}
+
+	return fields
+}
+
+func logInfo(msg string, method string, fields ...logger.Fields) {
+	logger.WithLayer(fmt.Sprintf("Service-%s", method), fields...).Info(msg)
+}
+
+func logErr(msg string, method string, fields ...logger.Fields) {
+	logger.WithLayer(fmt.Sprintf("Service-%s", method), fields...).Error(msg)
 }
 
-func logDebug(msg string, method string) {
-	logger.Log().WithField("layer", fmt.Sprintf("Service-%s", method)).Debug(msg)
+func logDebug(msg string, method string, fields ...logger.Fields) {
+	logger.WithLayer(fmt.Sprintf("Service-%s", method), fields...).Debug(msg)
 }
 
-func logTrace(msg string, method string) {
-	logger.Log().WithField("layer", fmt.Sprintf("Service-%s", method)).Trace(msg)
+func logTrace(msg string, method string, fields ...logger.Fields) {
+	logger.WithLayer(fmt.Sprintf("Service-%s", method), fields...).Trace(msg)
 }
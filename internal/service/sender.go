@@ -1,53 +1,196 @@
 package service
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"math/big"
+	"oracle-flare/pkg/alerts"
+	"oracle-flare/pkg/flare"
 	"oracle-flare/pkg/flare/contracts"
+	"oracle-flare/pkg/logger"
+	"oracle-flare/pkg/tracing"
 	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// commitBeforeEnd is a time before the price epoch end when prices are committed
+	commitBeforeEnd = time.Second * 20
+	// revealAfterEnd is a divisor of the reveal period: prices are revealed after its 1/3 passed since the epoch end
+	revealAfterEnd = 3
+	// finalizationDelay is a time after the reveal end when the price finalization is checked
+	finalizationDelay = time.Second * 15
 )
 
+var (
+	errStopped  = errors.New("sender stopped")
+	errNoTokens = errors.New("no tokens to commit")
+	errWithheld = errors.New("prices withheld")
+)
+
+// runSender is used to commit prices on each new price epoch and schedule the reveal
 func (s *coinAVGPriceSender) runSender() {
 	for {
 		select {
 		case <-s.stopSender:
-			logInfo("stop...", "Sender")
+			logInfo("stop...", s.method("Sender"))
 			return
-		case <-s.ticker.C:
-			logInfo(fmt.Sprintf("commiting price"), "Sender")
-
-			epoch, err := s.flare.GetCurrentPriceEpochData()
-			if err != nil {
-				logErr(fmt.Sprintln("err get epoch:", err.Error()), "Sender")
-				continue
+		case e := <-s.submissions:
+			switch e.Type {
+			case contracts.HashSubmitted:
+				s.committed.Store(e.EpochID.String(), struct{}{})
+				logInfo(fmt.Sprintf("commit confirmed for the epochID: %v tx: %s", e.EpochID, e.TxHash.Hex()), s.method("Sender"), chainFields(s.flare, e.EpochID).With(logger.FieldTxHash, e.TxHash.Hex()))
+			case contracts.PricesRevealed:
+				logInfo(fmt.Sprintf("reveal confirmed for the epochID: %v tx: %s", e.EpochID, e.TxHash.Hex()), s.method("Sender"), chainFields(s.flare, e.EpochID).With(logger.FieldTxHash, e.TxHash.Hex()))
 			}
+		case epoch := <-s.epochs:
+			// the signer can be removed from the whitelist at any time by a provider with more vote power
+			go s.refreshWhitelist()
 
-			epochID := epoch.EpochID
-			tokens := s.tokens
-			prices := s.parsePrices()
-			random := s.getRandom()
+			logInfo(fmt.Sprintf("epochID: %v current: %v end: %v reveal end: %v", epoch.EpochID, epoch.CurrentTimestamp, epoch.EndTimestamp, epoch.RevealEndTimestamp), s.method("Sender"), chainFields(s.flare, epoch.EpochID))
 
-			logInfo(fmt.Sprintf("epochID: %v current: %v reveal end: %v", epoch.EpochID, epoch.CurrentTimestamp, epoch.RevealEndTimestamp), "Sender")
+			// on-chain timestamps are converted to the local deadlines at the moment the epoch is received
+			now := time.Now()
+			commitAt := now.Add(time.Duration(epoch.EndTimestamp.Int64()-epoch.CurrentTimestamp.Int64())*time.Second - commitBeforeEnd)
+			revealPeriod := epoch.RevealEndTimestamp.Int64() - epoch.EndTimestamp.Int64()
+			revealAt := now.Add(time.Duration(epoch.EndTimestamp.Int64()+revealPeriod/revealAfterEnd-epoch.CurrentTimestamp.Int64()) * time.Second)
 
-			sleep, _ := time.ParseDuration(fmt.Sprintf("%vs", epoch.RevealEndTimestamp.Uint64()-epoch.CurrentTimestamp.Uint64()-60))
+			logInfo(fmt.Sprintf("time for commit: %v", time.Until(commitAt)), s.method("Sender"), chainFields(s.flare, epoch.EpochID))
+			revealEnd := now.Add(time.Duration(epoch.RevealEndTimestamp.Int64()-epoch.CurrentTimestamp.Int64()) * time.Second)
 
-			if err := s.flare.CommitPrices(epochID, tokens, prices, random); err != nil {
-				continue
-			}
+			// the epoch span is the trace root of the whole epoch submission, it is ended by the commit goroutine
+			ctx, _ := tracing.Start(context.Background(), "epoch", append(tracing.Epoch(s.flare.ChainID(), epoch.EpochID), attribute.String("provider", s.provider))...)
+			go s.commit(ctx, time.NewTimer(time.Until(commitAt)), revealAt, revealEnd, epoch)
+		}
+	}
+}
+
+// commit will wait the timer and then commit current prices for the epoch and schedule the reveal
+func (s *coinAVGPriceSender) commit(ctx context.Context, timer *time.Timer, revealAt time.Time, revealEnd time.Time, epoch *contracts.PriceEpochData) {
+	defer trace.SpanFromContext(ctx).End()
+
+	epochID := epoch.EpochID
+
+	_, aggregation := tracing.Start(ctx, "aggregation")
+	select {
+	case <-s.stopSender:
+		timer.Stop()
+		tracing.End(aggregation, errStopped)
+		return
+	case <-timer.C:
+	}
+	aggregation.End()
+
+	logInfo(fmt.Sprintf("commiting price for the epochID: %v", epochID), s.method("Sender"), chainFields(s.flare, epochID))
+
+	commitCtx, commitSpan := tracing.Start(ctx, "commit")
+
+	s.auditPrices(epochID)
+
+	tokens, prices := s.commitPrices()
+	if len(tokens) == 0 {
+		logAlert(alerts.MissedCommit, "", fmt.Sprintf("no tokens to commit for the epochID: %v", epochID), s.method("Sender"), chainFields(s.flare, epochID))
+		tracing.End(commitSpan, errNoTokens)
+		return
+	}
+
+	tokens, prices, ok := s.validator.validate(epochID, tokens, prices)
+	if !ok {
+		tracing.End(commitSpan, errWithheld)
+		return
+	}
+	commitSpan.SetAttributes(attribute.Int("tokens", len(tokens)))
+
+	random := s.getRandom()
+
+	err := s.flare.CommitPrices(commitCtx, epochID, tokens, prices, random)
+	tracing.End(commitSpan, err)
 
-			timer := time.NewTimer(sleep)
-			logInfo(fmt.Sprintf("time for reverl: %v", sleep), "Sender")
-			go s.reveal(timer, epochID, tokens, prices, random)
+	if err != nil {
+		if errors.Is(err, flare.ErrPaused) {
+			logWarn(fmt.Sprintf("commit is paused for the epochID: %v: %s", epochID, err.Error()), s.method("Sender"), chainFields(s.flare, epochID))
+		} else {
+			logAlert(alerts.MissedCommit, "", fmt.Sprintf("err commit for the epochID: %v: %s", epochID, err.Error()), s.method("Sender"), chainFields(s.flare, epochID))
 		}
+
+		return
+	}
+
+	logInfo(fmt.Sprintf("time for reveal: %v", time.Until(revealAt)), s.method("Sender"))
+	if !s.reveal(ctx, time.NewTimer(time.Until(revealAt)), epochID, tokens, prices, random) {
+		return
+	}
+
+	if tracing.Enabled() {
+		s.checkFinalization(ctx, revealEnd, epoch, tokens[0])
+	}
+}
+
+// reveal will wait the sleep time and then call the reveal smart-contract method. Returns false if the reveal failed
+// or the sender is stopped
+func (s *coinAVGPriceSender) reveal(ctx context.Context, timer *time.Timer, epochID *big.Int, indices []contracts.TokenID, prices []*big.Int, random *big.Int) bool {
+	logInfo(fmt.Sprintf("received for reveal: epochID %v, indices %v, prices %v, random %v", epochID, indices, prices, random), s.method("Sender"), chainFields(s.flare, epochID))
+
+	_, wait := tracing.Start(ctx, "reveal wait")
+	select {
+	case <-s.stopSender:
+		timer.Stop()
+		tracing.End(wait, errStopped)
+		return false
+	case <-timer.C:
+	}
+
+	_, confirmed := s.committed.LoadAndDelete(epochID.String())
+	wait.SetAttributes(attribute.Bool("commit_confirmed", confirmed))
+	wait.End()
+
+	if !confirmed {
+		logAlert(alerts.MissedCommit, "", fmt.Sprintf("commit is not confirmed yet for the epochID: %v", epochID), s.method("Sender"), chainFields(s.flare, epochID))
+	}
+
+	logInfo(fmt.Sprintf("revealing price for the epochID: %v", epochID.Int64()), s.method("Sender"), chainFields(s.flare, epochID))
+
+	revealCtx, revealSpan := tracing.Start(ctx, "reveal")
+	err := s.flare.RevealPrices(revealCtx, epochID, indices, prices, random)
+	tracing.End(revealSpan, err)
+
+	if err != nil {
+		logAlert(alerts.MissedReveal, "", fmt.Sprintf("err reveal for the epochID: %v: %s", epochID, err.Error()), s.method("Sender"), chainFields(s.flare, epochID))
+		return false
 	}
+
+	return true
 }
 
-// reveal will wait the sleep time and then call the reveal smart-contract method
-func (s *coinAVGPriceSender) reveal(timer *time.Timer, epochID *big.Int, indices []contracts.TokenID, prices []*big.Int, random *big.Int) {
-	logInfo(fmt.Sprintf("received for reveal: epochID %v, indices %v, prices %v, random %v", epochID, indices, prices, random), "Sender")
-	<-timer.C
-	logInfo(fmt.Sprintf("revealing price for the epochID: %v", epochID.Int64()), "Sender")
-	if err := s.flare.RevealPrices(epochID, indices, prices, random); err != nil {
-		logErr("err reveal", "Sender")
+// checkFinalization will wait the reveal end and then check the epoch price of the token is finalized. It is used by
+// the tracing only: the finalization is recorded as the span attribute
+func (s *coinAVGPriceSender) checkFinalization(ctx context.Context, revealEnd time.Time, epoch *contracts.PriceEpochData, token contracts.TokenID) {
+	_, span := tracing.Start(ctx, "finalization check", attribute.String("token", token.Name()))
+
+	timer := time.NewTimer(time.Until(revealEnd) + finalizationDelay)
+	select {
+	case <-s.stopSender:
+		timer.Stop()
+		tracing.End(span, errStopped)
+		return
+	case <-timer.C:
+	}
+
+	price, err := s.flare.GetCurrentPrice(token)
+	if errors.Is(err, flare.ErrNotSupported) {
+		// the FTSOv2 finalization is traced by the flare pkg signing
+		span.SetAttributes(attribute.Bool("supported", false))
+		span.End()
+		return
 	}
+	if err != nil {
+		tracing.End(span, err)
+		return
+	}
+
+	span.SetAttributes(attribute.Bool("finalized", price.Timestamp.Cmp(epoch.EndTimestamp) >= 0))
+	span.End()
 }
@@ -1,65 +1,151 @@
 package service
 
 import (
+	"fmt"
+	"sync"
+
+	"oracle-flare/config"
+	"oracle-flare/pkg/alerts"
+	"oracle-flare/pkg/exchanges"
 	"oracle-flare/pkg/flare"
+	"oracle-flare/pkg/restClient"
 	"oracle-flare/pkg/wsClient"
 )
 
 // IService is a service layer interface
 type IService interface {
-	// WhiteListAddress is used to add address to the smart-contract whitelist with given tokens
+	// WhiteListAddress is used to add address to the smart-contract whitelist with given tokens. The tx is not sent
+	// for the tokens the address is already whitelisted for or can not be whitelisted for by the whitelist check
 	WhiteListAddress(addressS string, indicesS []string) ([]bool, error)
+	// CheckWhiteListAddress is used to get whitelist status of the address for given tokens
+	CheckWhiteListAddress(addressS string, indicesS []string) ([]*WhitelistStatus, error)
 	// SendCoinAveragePrice is used to send coin average price from the ws service to the flare smart-contracts
 	SendCoinAveragePrice(tokens []string)
+	// UpdateTokens is used to change the tokens of the running senders without restarting them
+	UpdateTokens(tokens []string)
+	// UpdateValidation is used to change the prices validation rules of the running senders
+	UpdateValidation(conf *config.Validation)
+	// ResetWithheld is used to accept the next prices of the tokens withheld by the previous price rule as their new
+	// price level
+	ResetWithheld()
+	// Resubscribe is used to subscribe the price sources of the running senders again, e.g. after their configs are
+	// changed
+	Resubscribe()
 	// Close is used to stop the service
 	Close()
 }
 
 // service is a service-layer struct implementing IService interface
 type service struct {
+	// name is the data-provider name used in the logs. It is empty for the single provider
+	name     string
 	flare    flare.IFlare
 	wsClient wsClient.IWSClient
+	// exchanges is an optional direct exchanges price source. It is used instead of the ws if wsClient is nil
+	exchanges exchanges.IExchangesClient
+	// restClient is an optional rest price source. It is used standalone if there is no other source and as the
+	// fallback otherwise
+	restClient restClient.IRestClient
+	// conversion is the quote currencies to USD conversion configs
+	conversion *config.Conversion
+	// validation is the prices validation configs. It is guarded by the sendersMu
+	validation *config.Validation
+	// fastUpdates is the FTSOv2 fast updates configs. The fast updates are submitted by the senders if it is enabled
+	fastUpdates *config.FastUpdates
 
+	// sendersMu guards the avgPriceSenders and the validation. Senders are started from the goroutines, while the
+	// configs are reloaded
+	sendersMu       sync.RWMutex
 	avgPriceSenders []*coinAVGPriceSender
-	resubscribe     chan struct{}
+	// wsStates receives ws client connection state transitions. It is nil if there is no ws client
+	wsStates chan wsClient.State
+	// stop is closed on the service close to stop the ws states listener
+	stop      chan struct{}
+	closeOnce sync.Once
 }
 
-// NewService is used to get new service instance
-func NewService(ws wsClient.IWSClient, flare flare.IFlare) IService {
-	logInfo("creating new service...", "Init")
+// NewService is used to get new service instance. Name is the data-provider name used in the logs, it is empty for
+// the single provider
+func NewService(name string, ws wsClient.IWSClient, ex exchanges.IExchangesClient, rest restClient.IRestClient, flare flare.IFlare, conversion *config.Conversion, validation *config.Validation, fastUpdates *config.FastUpdates) IService {
+	logInfo("creating new service...", providerMethod(name, "Init"))
 	c := &service{
+		name:            name,
 		avgPriceSenders: make([]*coinAVGPriceSender, 0),
+		conversion:      conversion,
+		validation:      validation,
+		fastUpdates:     fastUpdates,
+		stop:            make(chan struct{}),
 	}
 
 	if ws != nil {
 		c.wsClient = ws
-		c.resubscribe = ws.Resubscribe()
+		c.wsStates = ws.SubscribeStates()
+	}
+
+	if ex != nil {
+		c.exchanges = ex
+	}
+
+	if rest != nil {
+		c.restClient = rest
 	}
 
 	if flare != nil {
 		c.flare = flare
 	}
 
-	go c.listenResubscribe()
+	if c.wsStates != nil {
+		go c.listenWSStates()
+	}
 
 	return c
 }
 
-func (s *service) listenResubscribe() {
+// listenWSStates is used to react to the ws client connection state transitions. Subscriptions are replayed by the
+// ws client itself on reconnect
+func (s *service) listenWSStates() {
 	for {
 		select {
-		case <-s.resubscribe:
-			for _, v := range s.avgPriceSenders {
-				v.resubscribe <- struct{}{}
+		case <-s.stop:
+			return
+		case state := <-s.wsStates:
+			switch state {
+			case wsClient.Connected:
+				logInfo("ws price source connected", s.method("WSState"))
+			case wsClient.Reconnecting:
+				logAlert(alerts.PriceSourceDown, "ws", "ws price source connection lost, prices are not updated until reconnect", s.method("WSState"))
+			default:
+				logInfo(fmt.Sprintf("ws price source state: %s", state), s.method("WSState"))
 			}
 		}
 	}
 }
 
+// method is used to get the log method name of the service provider
+func (s *service) method(name string) string {
+	return providerMethod(s.name, name)
+}
+
+// priceSource is used to get the primary coin average price source. Returns nil if only the rest source is available
+func (s *service) priceSource() priceSource {
+	switch {
+	case s.wsClient != nil:
+		return s.wsClient
+	case s.exchanges != nil:
+		return s.exchanges
+	default:
+		return nil
+	}
+}
+
 // Close is used to close the service and all dependencies
 func (s *service) Close() {
-	logInfo("service closing...", "Close")
-	for _, v := range s.avgPriceSenders {
-		v.close()
-	}
+	s.closeOnce.Do(func() {
+		logInfo("service closing...", s.method("Close"))
+		close(s.stop)
+
+		for _, v := range s.senders() {
+			v.close()
+		}
+	})
 }
@@ -0,0 +1,295 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"oracle-flare/config"
+	"oracle-flare/pkg/alerts"
+	"oracle-flare/pkg/flare"
+	"oracle-flare/pkg/flare/contracts"
+	"oracle-flare/pkg/logger"
+)
+
+// priceDecimals is a number of decimals of the committed prices
+const priceDecimals = 5
+
+// priceValidator is used to validate prices before the commit. Tokens violating the rules are withheld and the whole
+// commit is withheld when the circuit breaker is tripped
+type priceValidator struct {
+	// provider is the data-provider name used in the logs
+	provider string
+	// conf is the current rules config. It is swapped on the config reload
+	conf  atomic.Pointer[validationRules]
+	flare flare.IFlare
+
+	mu sync.Mutex
+	// ticks are the numbers of prices received for the tokens since the last commit
+	ticks map[contracts.TokenID]int
+	// previous are the last committed prices
+	previous map[contracts.TokenID]*big.Int
+	// withheld are the numbers of consecutive epochs the tokens are withheld by the previous price rule
+	withheld map[contracts.TokenID]int
+	// tripped is true while the circuit breaker is tripped
+	tripped bool
+}
+
+// validationRules is the validation config with the parsed token rules
+type validationRules struct {
+	*config.Validation
+	// maxWithheld are the max withheld epochs of the tokens with own rule
+	maxWithheld map[contracts.TokenID]int
+}
+
+// newValidationRules is used to parse the token rules of the validation config
+func newValidationRules(conf *config.Validation) (*validationRules, error) {
+	r := &validationRules{Validation: conf, maxWithheld: make(map[contracts.TokenID]int)}
+
+	for _, s := range conf.TokenMaxWithheldEpochs {
+		name, value, ok := strings.Cut(s, "=")
+		epochs, err := strconv.Atoi(value)
+		if !ok || err != nil || epochs < 0 {
+			return nil, fmt.Errorf("invalid token max withheld epochs %s, TOKEN=EPOCHS expected", s)
+		}
+
+		token := contracts.GetTokenIDFromName(strings.ToUpper(name))
+		if token == contracts.UnknownToken {
+			return nil, fmt.Errorf("token %s not supported", name)
+		}
+
+		r.maxWithheld[token] = epochs
+	}
+
+	return r, nil
+}
+
+// maxWithheldEpochs is used to get the max withheld epochs of the token. 0 means the token is never auto-accepted
+func (r *validationRules) maxWithheldEpochs(token contracts.TokenID) int {
+	if epochs, ok := r.maxWithheld[token]; ok {
+		return epochs
+	}
+
+	return r.MaxWithheldEpochs
+}
+
+// CheckValidation is used to check the validation config: the thresholds and the token rules
+func CheckValidation(conf *config.Validation) error {
+	if conf.MaxChangePrevious < 0 || conf.MaxChangeFinalized < 0 || conf.MinTicks < 0 || conf.MaxRejected < 0 || conf.MaxWithheldEpochs < 0 {
+		return fmt.Errorf("validation thresholds can not be negative")
+	}
+
+	_, err := newValidationRules(conf)
+	return err
+}
+
+// newPriceValidator is used to get new priceValidator instance. Nil config disables all rules
+func newPriceValidator(provider string, conf *config.Validation, flare flare.IFlare) *priceValidator {
+	if conf == nil {
+		conf = &config.Validation{}
+	}
+
+	v := &priceValidator{
+		provider: provider,
+		flare:    flare,
+		ticks:    make(map[contracts.TokenID]int),
+		previous: make(map[contracts.TokenID]*big.Int),
+		withheld: make(map[contracts.TokenID]int),
+	}
+
+	v.setConfig(conf)
+
+	return v
+}
+
+// method is used to get the log method name of the validator provider
+func (v *priceValidator) method(name string) string {
+	return providerMethod(v.provider, name)
+}
+
+// updateConfig is used to change the rules. They are applied from the next commit
+func (v *priceValidator) updateConfig(conf *config.Validation) {
+	logInfo(fmt.Sprintf("max change previous: %v max change finalized: %v min ticks: %v max rejected: %v max withheld epochs: %v %v",
+		conf.MaxChangePrevious, conf.MaxChangeFinalized, conf.MinTicks, conf.MaxRejected, conf.MaxWithheldEpochs, conf.TokenMaxWithheldEpochs), v.method("Validator"))
+	v.setConfig(conf)
+}
+
+// setConfig is used to store the rules of the config. The config is checked before, so the invalid token rules are
+// only logged and skipped
+func (v *priceValidator) setConfig(conf *config.Validation) {
+	rules, err := newValidationRules(conf)
+	if err != nil {
+		logWarn(fmt.Sprintln("invalid token rules, the default rules are used:", err.Error()), v.method("Validator"))
+		rules = &validationRules{Validation: conf, maxWithheld: map[contracts.TokenID]int{}}
+	}
+
+	v.conf.Store(rules)
+}
+
+// resetWithheld is used to accept the next prices of the tokens withheld by the previous price rule as their new
+// price level
+func (v *priceValidator) resetWithheld() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	for token, epochs := range v.withheld {
+		if epochs > 0 {
+			logInfo(fmt.Sprintf("%s is withheld for %v epochs, the next price is accepted as the new price level", token.Name(), epochs), v.method("Validator"), chainFields(v.flare, nil).With(logger.FieldToken, token.Name()))
+			delete(v.previous, token)
+		}
+	}
+
+	v.withheld = make(map[contracts.TokenID]int)
+}
+
+// tick is used to count the price received for the token
+func (v *priceValidator) tick(token contracts.TokenID) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.ticks[token]++
+}
+
+// validate is used to get the tokens and prices passing all rules. Returns false if the circuit breaker is tripped and
+// nothing should be committed. Ticks are reset for the next epoch
+func (v *priceValidator) validate(epochID *big.Int, tokens []contracts.TokenID, prices []*big.Int) ([]contracts.TokenID, []*big.Int, bool) {
+	v.mu.Lock()
+	ticks := v.ticks
+	v.ticks = make(map[contracts.TokenID]int)
+	v.mu.Unlock()
+
+	conf := v.conf.Load()
+
+	validTokens := make([]contracts.TokenID, 0, len(tokens))
+	validPrices := make([]*big.Int, 0, len(prices))
+	rejected := 0
+
+	for i, t := range tokens {
+		if err := v.check(conf, t, prices[i], ticks[t]); err != nil {
+			logAlert(alerts.PriceWithheld, t.Name(), fmt.Sprintf("epochID: %v %s price %v withheld: %s", epochID, t.Name(), prices[i], err.Error()), v.method("Validator"), chainFields(v.flare, epochID).With(logger.FieldToken, t.Name()))
+			rejected++
+			continue
+		}
+
+		validTokens = append(validTokens, t)
+		validPrices = append(validPrices, prices[i])
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if len(validTokens) == 0 || (conf.MaxRejected > 0 && rejected >= conf.MaxRejected) {
+		if !v.tripped {
+			logAlert(alerts.CircuitBreaker, "", fmt.Sprintf("epochID: %v circuit breaker tripped: %v of %v tokens rejected, commit withheld", epochID, rejected, len(tokens)), v.method("Validator"), chainFields(v.flare, epochID))
+		}
+		v.tripped = true
+
+		return nil, nil, false
+	}
+
+	if v.tripped {
+		logInfo(fmt.Sprintf("epochID: %v circuit breaker reset: %v of %v tokens rejected", epochID, rejected, len(tokens)), v.method("Validator"), chainFields(v.flare, epochID))
+		v.tripped = false
+	}
+
+	for i, t := range validTokens {
+		v.previous[t] = validPrices[i]
+	}
+
+	return validTokens, validPrices, true
+}
+
+// check is used to check the token price with all enabled rules
+func (v *priceValidator) check(conf *validationRules, token contracts.TokenID, price *big.Int, ticks int) error {
+	if price == nil || price.Sign() <= 0 {
+		return fmt.Errorf("no price")
+	}
+
+	if conf.MinTicks > 0 && ticks < conf.MinTicks {
+		return fmt.Errorf("%v ticks received, min %v", ticks, conf.MinTicks)
+	}
+
+	value := toFloat(price, priceDecimals)
+
+	if conf.MaxChangePrevious > 0 {
+		if err := v.checkPrevious(conf.MaxChangePrevious, conf.maxWithheldEpochs(token), token, price, value); err != nil {
+			return err
+		}
+	}
+
+	if conf.MaxChangeFinalized > 0 {
+		current, err := v.flare.GetCurrentPrice(token)
+		if errors.Is(err, flare.ErrNotSupported) {
+			return nil
+		}
+
+		if err != nil {
+			logWarn(fmt.Sprintf("err get %s finalized price, rule skipped: %s", token.Name(), err.Error()), v.method("Validator"), chainFields(v.flare, nil).With(logger.FieldToken, token.Name()))
+			return nil
+		}
+
+		if current.Price.Sign() <= 0 {
+			return nil
+		}
+
+		finalized := toFloat(current.Price, int(current.Decimals.Int64()))
+		if change := relativeChange(finalized, value); change > conf.MaxChangeFinalized {
+			return fmt.Errorf("%.2f%% change vs. finalized price %v, max %.2f%%", change*100, finalized, conf.MaxChangeFinalized*100)
+		}
+	}
+
+	return nil
+}
+
+// checkPrevious is used to check the price change vs. the previous committed price. The new price level is accepted
+// after maxWithheld consecutive violations, it is never accepted if maxWithheld is 0
+func (v *priceValidator) checkPrevious(maxChange float64, maxWithheld int, token contracts.TokenID, price *big.Int, value float64) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	prev, ok := v.previous[token]
+	if !ok {
+		return nil
+	}
+
+	previous := toFloat(prev, priceDecimals)
+	change := relativeChange(previous, value)
+
+	if change <= maxChange {
+		v.withheld[token] = 0
+		return nil
+	}
+
+	v.withheld[token]++
+	if maxWithheld > 0 && v.withheld[token] > maxWithheld {
+		logWarn(fmt.Sprintf("%s price %v is withheld for %v epochs, accepted as the new price level", token.Name(), value, maxWithheld), v.method("Validator"), chainFields(v.flare, nil).With(logger.FieldToken, token.Name()))
+		v.withheld[token] = 0
+		return nil
+	}
+
+	if maxWithheld == 0 {
+		return fmt.Errorf("%.2f%% change vs. previous price %v, max %.2f%%, withheld until reset with SIGHUP", change*100, previous, maxChange*100)
+	}
+
+	return fmt.Errorf("%.2f%% change vs. previous price %v, max %.2f%%", change*100, previous, maxChange*100)
+}
+
+// toFloat is used to get the float value of the price with decimals
+func toFloat(price *big.Int, decimals int) float64 {
+	f, _ := new(big.Float).Quo(new(big.Float).SetInt(price), big.NewFloat(math.Pow10(decimals))).Float64()
+	return f
+}
+
+// relativeChange is used to get the relative change of the value vs. the reference value
+func relativeChange(reference float64, value float64) float64 {
+	if reference == 0 {
+		return 0
+	}
+
+	return math.Abs(value-reference) / reference
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -9,46 +10,82 @@ import (
 	"oracle-flare/pkg/flare/contracts"
 )
 
-func (s *service) WhiteListAddress(addressS string, indicesS []string) ([]bool, error) {
-	if addressS == "" {
-		return nil, fmt.Errorf("no address given")
+// WhitelistStatus is a whitelist check result for the one token
+type WhitelistStatus struct {
+	// Token is a token WS name
+	Token string
+	// Whitelisted is true if address is already in the token whitelist
+	Whitelisted bool
+	// Voters is a current number of whitelisted data-providers
+	Voters int
+	// MaxVoters is a max number of whitelisted data-providers
+	MaxVoters *big.Int
+	// MinVotePower is a vote power weight needed to replace the weakest whitelisted data-provider. Zero if there are
+	// free slots
+	MinVotePower *big.Int
+	// VotePower is the address vote power weight, calculated by the whitelister along with the whitelisted providers
+	VotePower *big.Int
+	// ChilledUntil is the reward epoch until which the address is chilled
+	ChilledUntil *big.Int
+	// RewardEpoch is the current reward epoch
+	RewardEpoch *big.Int
+	// Err is the token check error. The other token fields are not set if it is not nil
+	Err error
+}
+
+// IsChilled is used to check if the address is chilled in the current reward epoch
+func (w *WhitelistStatus) IsChilled() bool {
+	return w.ChilledUntil.Cmp(w.RewardEpoch) > 0
+}
+
+// CanBeWhitelisted is used to check if the whitelisting tx is worth sending
+func (w *WhitelistStatus) CanBeWhitelisted() bool {
+	if w.Err != nil || w.Whitelisted || w.IsChilled() {
+		return false
 	}
 
-	if len(indicesS) == 0 {
-		return nil, fmt.Errorf("no indices given")
+	if big.NewInt(int64(w.Voters)).Cmp(w.MaxVoters) < 0 {
+		return true
+	}
+
+	return w.VotePower.Cmp(w.MinVotePower) > 0
+}
+
+func (s *service) WhiteListAddress(addressS string, indicesS []string) ([]bool, error) {
+	statuses, err := s.CheckWhiteListAddress(addressS, indicesS)
+	if err != nil {
+		return nil, err
 	}
 
 	address := common.HexToAddress(addressS)
 
 	res := []bool{}
-	for _, i := range indicesS {
-		logInfo(fmt.Sprintln("whitelisting for:", i), "WhiteListAddress")
+	for n, i := range indicesS {
+		logInfo(fmt.Sprintln("whitelisting for:", i), s.method("WhiteListAddress"))
 
 		index := contracts.GetTokenIDFromName(i)
+		status := statuses[n]
 
-		if index == contracts.UnknownToken {
-			logWarn("unknown token", "WhiteListAddress")
+		if status.Err != nil {
+			logErr(fmt.Sprintln("err whitelist check:", status.Err.Error()), s.method("WhiteListAddress"))
 			res = append(res, false)
 			continue
 		}
 
-		isWhitListed, err := s.isAddressWhitelisted(index, address)
-		if err != nil {
-			logErr(fmt.Sprintln("err isAddressWhitelisted:", err.Error()), "WhiteListAddress")
-			res = append(res, false)
+		if status.Whitelisted {
+			logInfo("address already whitelisted", s.method("WhiteListAddress"))
+			res = append(res, true)
 			continue
 		}
 
-		if isWhitListed {
-			if err != nil {
-				logErr("address already whitelisted", "WhiteListAddress")
-				res = append(res, true)
-				continue
-			}
+		if !status.CanBeWhitelisted() {
+			logWarn(fmt.Sprintf("address can not be whitelisted: voters %v/%v, min vote power %v, vote power %v, chilled until %v", status.Voters, status.MaxVoters, status.MinVotePower, status.VotePower, status.ChilledUntil), s.method("WhiteListAddress"))
+			res = append(res, false)
+			continue
 		}
 
 		if err := s.flare.RequestWhitelistingVoter(address, index); err != nil {
-			logErr(fmt.Sprintln("err RequestWhitelistingVoter:", err.Error()), "WhiteListAddress")
+			logErr(fmt.Sprintln("err RequestWhitelistingVoter:", err.Error()), s.method("WhiteListAddress"))
 			res = append(res, false)
 			continue
 		}
@@ -56,18 +93,18 @@ func (s *service) WhiteListAddress(addressS string, indicesS []string) ([]bool,
 		// wait for the tx
 		time.Sleep(time.Second * 3)
 
-		isWhitListed, err = s.isAddressWhitelisted(index, address)
+		isWhitListed, err := s.isAddressWhitelisted(index, address)
 		if err != nil {
-			logErr(fmt.Sprintln("err isAddressWhitelisted:", err.Error()), "WhiteListAddress")
+			logErr(fmt.Sprintln("err isAddressWhitelisted:", err.Error()), s.method("WhiteListAddress"))
 			res = append(res, false)
 			continue
 		}
 
 		if isWhitListed {
-			logInfo("token whitelisted", "WhiteListAddress")
+			logInfo("token whitelisted", s.method("WhiteListAddress"))
 			res = append(res, true)
 		} else {
-			logWarn("token not whitelisted", "WhiteListAddress")
+			logWarn("token not whitelisted", s.method("WhiteListAddress"))
 			res = append(res, false)
 		}
 	}
@@ -82,10 +119,110 @@ func (s *service) isAddressWhitelisted(index contracts.TokenID, target common.Ad
 	}
 
 	for _, a := range addresses {
-		if a.Hex() == target.Hex() {
+		if a == target {
 			return true, nil
 		}
 	}
 
 	return false, nil
 }
+
+func (s *service) CheckWhiteListAddress(addressS string, indicesS []string) ([]*WhitelistStatus, error) {
+	if addressS == "" {
+		return nil, fmt.Errorf("no address given")
+	}
+
+	if len(indicesS) == 0 {
+		return nil, fmt.Errorf("no indices given")
+	}
+
+	address := common.HexToAddress(addressS)
+
+	rewardEpoch, err := s.flare.GetCurrentRewardEpoch()
+	if err != nil {
+		return nil, fmt.Errorf("get current reward epoch: %w", err)
+	}
+
+	chilledUntil, err := s.flare.ChilledUntilRewardEpoch(address)
+	if err != nil {
+		return nil, fmt.Errorf("get chilled until reward epoch: %w", err)
+	}
+
+	res := []*WhitelistStatus{}
+	for _, i := range indicesS {
+		logInfo(fmt.Sprintln("checking whitelist for:", i), s.method("CheckWhiteListAddress"))
+
+		index := contracts.GetTokenIDFromName(i)
+
+		var status *WhitelistStatus
+		if index == contracts.UnknownToken {
+			logWarn("unknown token", s.method("CheckWhiteListAddress"))
+			status = &WhitelistStatus{Token: i, Err: fmt.Errorf("unknown token")}
+		} else if status, err = s.whitelistStatus(index, address); err != nil {
+			logErr(fmt.Sprintln("err whitelistStatus:", err.Error()), s.method("CheckWhiteListAddress"))
+			status = &WhitelistStatus{Token: i, Err: err}
+		}
+
+		status.ChilledUntil = chilledUntil
+		status.RewardEpoch = rewardEpoch
+
+		res = append(res, status)
+	}
+
+	return res, nil
+}
+
+// whitelistStatus is used to get token related part of the WhitelistStatus for given address. The vote powers are the
+// whitelister weights: they are calculated along with the whitelisted providers and the not whitelisted address is
+// the last one, so it needs more than the weakest provider weight to replace it
+func (s *service) whitelistStatus(index contracts.TokenID, target common.Address) (*WhitelistStatus, error) {
+	addresses, err := s.flare.GetFtsoWhitelistedPriceProviders(index)
+	if err != nil {
+		return nil, err
+	}
+
+	maxVoters, err := s.flare.MaxVotersForFtso(index)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &WhitelistStatus{
+		Token:        index.Name(),
+		Voters:       len(addresses),
+		MaxVoters:    maxVoters,
+		MinVotePower: big.NewInt(0),
+	}
+
+	targetIndex := len(addresses)
+	for i, a := range addresses {
+		if a == target {
+			status.Whitelisted = true
+			targetIndex = i
+		}
+	}
+
+	voters := addresses
+	if !status.Whitelisted {
+		voters = append(voters[:len(voters):len(voters)], target)
+	}
+
+	weights, err := s.flare.GetVotePowerWeights(index, voters)
+	if err != nil {
+		return nil, err
+	}
+
+	status.VotePower = weights[targetIndex]
+
+	// there is a free slot, so any vote power is enough
+	if big.NewInt(int64(status.Voters)).Cmp(maxVoters) < 0 {
+		return status, nil
+	}
+
+	for i := range addresses {
+		if i == 0 || weights[i].Cmp(status.MinVotePower) < 0 {
+			status.MinVotePower = weights[i]
+		}
+	}
+
+	return status, nil
+}
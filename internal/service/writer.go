@@ -2,61 +2,154 @@ package service
 
 import (
 	"fmt"
+	"math"
 	"math/big"
 	"time"
 
+	"oracle-flare/pkg/alerts"
 	"oracle-flare/pkg/flare/contracts"
+	"oracle-flare/pkg/logger"
 	"oracle-flare/pkg/wsClient"
 )
 
+const (
+	// frequencyMS is a coin average price stream frequency in milliseconds
+	frequencyMS = 90000
+	// quietTimeout is a time without prices after which the primary stream is considered quiet and the rest fallback
+	// is started. Two missed stream updates are tolerated
+	quietTimeout = time.Duration(frequencyMS)*time.Millisecond*2 + time.Second*20
+)
+
 // run is used to run reveal-submit flow
 func (s *coinAVGPriceSender) runWriter() {
-	logInfo("start", "Writer")
-	go s.listenAndSendARGPrice(s.tokenNames(), s.id, 90000, s.stream, s.stopWriter)
+	logInfo("start", s.method("Writer"))
+	go s.listenAndSendARGPrice(s.stopWriter)
 
-	if err := s.subscribeCoinAveragePrice(s.tokenNames(), s.id, 90000, s.stream); err != nil {
-		s.close()
+	if s.source == nil {
+		logInfo("primary price source is disabled, rest price source is used", s.method("Writer"))
+		s.subscribeREST()
+		return
+	}
+
+	// the ws subscription is registered in the ws client and replayed on each reconnect, so it is sent only once
+	if err := s.source.SubscribeCoinAveragePrice(s.sourceCoins(), s.id, frequencyMS, s.stream); err != nil {
+		logWarn(fmt.Sprintln("subscription is not active yet, ws subscriptions are sent again on reconnect:", err.Error()), s.method("Writer"), logger.Fields{logger.FieldWSSubscriptionID: s.id})
 	}
 }
 
-// subscribeCoinAveragePrice is used to send subscribe message to the ws server
-func (s *coinAVGPriceSender) subscribeCoinAveragePrice(tokens []string, id int, freq int, stream chan *wsClient.CoinAveragePriceStream) error {
-	if err := s.wsClient.SubscribeCoinAveragePrice(tokens, id, freq, stream); err != nil {
-		time.Sleep(time.Second * 5)
-		return s.subscribeCoinAveragePrice(tokens, id, freq, stream)
+// subscribeREST is used to start polling prices from the rest source. Returns true if the polling is started
+func (s *coinAVGPriceSender) subscribeREST() bool {
+	if s.restClient == nil {
+		logErr("no price source available: rest price source is not configured", s.method("Writer"))
+		return false
 	}
 
-	return nil
+	if err := s.restClient.SubscribeCoinAveragePrice(s.sourceCoins(), s.id, s.restStream); err != nil {
+		logErr(fmt.Sprintln("err subscribe rest price source:", err.Error()), s.method("Writer"), logger.Fields{logger.FieldWSSubscriptionID: s.id})
+		return false
+	}
+
+	return true
 }
 
-// listenAndSendARGPrice is used to listen to the CoinAveragePriceStream chanel and send data to the flare smart contracts.
+// listenAndSendARGPrice is used to listen to the CoinAveragePriceStream chanels and send data to the flare smart contracts.
 // Sending flow is based on Flare documentation. Price data is sent each 3 minutes and reveal is send in the reveal timing
-// received from the flare smart-contract
-func (s *coinAVGPriceSender) listenAndSendARGPrice(tokens []string, id int, freq int, stream chan *wsClient.CoinAveragePriceStream, stopWriter chan struct{}) {
+// received from the flare smart-contract. The rest fallback is started when the primary stream goes quiet and stopped
+// when it resumes
+func (s *coinAVGPriceSender) listenAndSendARGPrice(stopWriter chan struct{}) {
+	var quiet <-chan time.Time
+	if s.source != nil && s.restClient != nil {
+		ticker := time.NewTicker(quietTimeout / 4)
+		defer ticker.Stop()
+		quiet = ticker.C
+	}
+
+	lastPrimary := time.Now()
+	fallback := false
+
 	for {
 		select {
 		case <-stopWriter:
-			logInfo("stop...", "Writer")
+			logInfo("stop...", s.method("Writer"))
 			return
-		case <-s.resubscribe:
-			if err := s.subscribeCoinAveragePrice(tokens, id, freq, stream); err != nil {
-				logErr(fmt.Sprintln("err resubscribe:", err.Error()), "Writer")
-				return
+		case <-quiet:
+			if fallback || time.Since(lastPrimary) < quietTimeout {
+				continue
 			}
-		case data := <-stream:
-			logInfo(fmt.Sprintf("received data on the %s coin", data.Coin), "Writer")
 
-			tokenID := contracts.GetTokenIDFromName(data.Coin)
-			if tokenID == contracts.UnknownToken && tokenID.Index().Int64() < 0 {
-				logErr("received unknown tokenID", "Writer")
-				continue
+			logAlert(alerts.PriceSourceDown, "primary", fmt.Sprintf("no primary source prices for %v, starting rest fallback", time.Since(lastPrimary).Round(time.Second)), s.method("Writer"))
+			fallback = s.subscribeREST()
+		case data := <-s.stream:
+			lastPrimary = time.Now()
+
+			if fallback {
+				logInfo("primary source prices resumed, stopping rest fallback", s.method("Writer"))
+				if err := s.restClient.Unsubscribe(s.id); err != nil {
+					logWarn(fmt.Sprintln("err unsubscribe rest:", err.Error()), s.method("Writer"), logger.Fields{logger.FieldWSSubscriptionID: s.id})
+				}
+				fallback = false
 			}
 
-			price := big.NewFloat(data.Value)
-			price = price.Mul(price, big.NewFloat(100000))
-			integer, _ := price.Int64()
+			s.storePrice(data)
+		case data := <-s.restStream:
+			s.storePrice(data)
+		case <-s.resubscribe:
+			s.resubscribeSources(fallback)
+		}
+	}
+}
+
+// resubscribeSources is used to subscribe the price sources again with the changed tokens
+func (s *coinAVGPriceSender) resubscribeSources(fallback bool) {
+	coins := s.sourceCoins()
+	logInfo(fmt.Sprintln("resubscribing price sources on coins:", coins), s.method("Writer"), logger.Fields{logger.FieldWSSubscriptionID: s.id})
 
-			s.prices.Store(tokenID, big.NewInt(integer))
+	if s.source != nil {
+		if err := s.source.Unsubscribe(s.id); err != nil {
+			logWarn(fmt.Sprintln("err unsubscribe:", err.Error()), s.method("Writer"), logger.Fields{logger.FieldWSSubscriptionID: s.id})
+		}
+
+		if err := s.source.SubscribeCoinAveragePrice(coins, s.id, frequencyMS, s.stream); err != nil {
+			logWarn(fmt.Sprintln("subscription is not active yet, ws subscriptions are sent again on reconnect:", err.Error()), s.method("Writer"), logger.Fields{logger.FieldWSSubscriptionID: s.id})
 		}
 	}
+
+	if s.restClient != nil && (s.source == nil || fallback) {
+		if err := s.restClient.Unsubscribe(s.id); err != nil {
+			logWarn(fmt.Sprintln("err unsubscribe rest:", err.Error()), s.method("Writer"), logger.Fields{logger.FieldWSSubscriptionID: s.id})
+		}
+
+		s.subscribeREST()
+	}
+}
+
+// storePrice is used to convert the received price to USD and store it for the next submit-reveal flow
+func (s *coinAVGPriceSender) storePrice(data *wsClient.CoinAveragePriceStream) {
+	logInfo(fmt.Sprintf("received data on the %s coin", data.Coin), s.method("Writer"), logger.Fields{logger.FieldToken: data.Coin, logger.FieldWSSubscriptionID: s.id})
+
+	usd, path, err := s.conv.toUSD(data.Coin, data.Quote, data.Value)
+	if err != nil {
+		logWarn(fmt.Sprintf("err convert %s price to USD: %s", data.Coin, err.Error()), s.method("Writer"), logger.Fields{logger.FieldToken: data.Coin, logger.FieldWSSubscriptionID: s.id})
+		return
+	}
+
+	if isUSD(data.Quote) {
+		s.conv.observe(data.Coin, usd)
+	}
+
+	tokenID := contracts.GetTokenIDFromName(data.Coin)
+	if tokenID == contracts.UnknownToken {
+		logErr("received unknown tokenID", s.method("Writer"))
+		return
+	}
+
+	logDebug(fmt.Sprintf("%s price conversion: %s", data.Coin, path), s.method("Writer"), logger.Fields{logger.FieldToken: data.Coin, logger.FieldWSSubscriptionID: s.id})
+
+	price := big.NewFloat(usd)
+	price = price.Mul(price, big.NewFloat(math.Pow10(priceDecimals)))
+	integer, _ := price.Int64()
+
+	s.prices.Store(tokenID, &tokenPrice{value: big.NewInt(integer), at: time.Now()})
+	s.audit.Store(tokenID, path)
+	s.validator.tick(tokenID)
 }
@@ -0,0 +1,299 @@
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"text/template"
+	"time"
+
+	"oracle-flare/config"
+)
+
+const (
+	// requestTimeout is a max time of the single webhook request
+	requestTimeout = time.Second * 10
+	// queueSize is a max number of the alerts waiting for the delivery. New alerts are dropped if it is full, so the
+	// alerting never blocks the callers
+	queueSize = 100
+	// rateWindow is the webhook rate limit window
+	rateWindow = time.Minute
+)
+
+// Alert is a notable event which needs the operator attention
+type Alert struct {
+	Event Event
+	// Source is the alerting component, e.g. the log layer
+	Source string
+	// Key is the alert subject within the event and source, e.g. the token name. It can be empty
+	Key     string
+	Message string
+	Time    time.Time
+}
+
+// dedupKey is used to get the deduplication key of the alert
+func (a *Alert) dedupKey() string {
+	return strings.Join([]string{a.Event.String(), a.Source, a.Key}, "/")
+}
+
+// IAlerter is an alerts pkg interface
+type IAlerter interface {
+	// Notify is used to deliver the alert to the webhooks. It does not block, duplicated alerts are dropped
+	Notify(a *Alert)
+	// Close is used to stop the delivery
+	Close()
+}
+
+// alerter is an alerts pkg struct implementing IAlerter interface
+type alerter struct {
+	webhooks []*webhook
+	dedup    time.Duration
+	http     *http.Client
+
+	// mu guards the sent
+	mu sync.Mutex
+	// sent are the last sent times mapped by the alert deduplication keys
+	sent map[string]time.Time
+
+	queue chan *Alert
+	stop  chan struct{}
+}
+
+// webhook is a delivery webhook with its payload template and rate limit
+type webhook struct {
+	conf *config.Webhook
+	tmpl *template.Template
+	// events are the events sent to the webhook. All events are sent if it is nil
+	events map[Event]struct{}
+	// limit is a max number of the alerts per rate window, sent are the send times within the window
+	limit int
+	sent  []time.Time
+}
+
+// NewAlerter is used to get new alerter instance. The webhooks configs are validated
+func NewAlerter(conf *config.Alerts) (IAlerter, error) {
+	a := &alerter{
+		dedup: time.Duration(conf.DedupSec) * time.Second,
+		http:  &http.Client{Timeout: requestTimeout},
+		sent:  make(map[string]time.Time),
+		queue: make(chan *Alert, queueSize),
+		stop:  make(chan struct{}),
+	}
+
+	for _, w := range conf.Webhooks {
+		hook, err := newWebhook(w, conf.RateLimit)
+		if err != nil {
+			return nil, fmt.Errorf("webhook %s: %w", w.Name, err)
+		}
+
+		a.webhooks = append(a.webhooks, hook)
+	}
+
+	go a.run()
+
+	return a, nil
+}
+
+// CheckConfig is used to validate the alerts config: the limits and the webhooks urls, formats, templates and events
+func CheckConfig(conf *config.Alerts) error {
+	if conf.DedupSec < 0 || conf.RateLimit < 0 {
+		return fmt.Errorf("alerts dedup and rate limit can not be negative")
+	}
+
+	names := make(map[string]struct{}, len(conf.Webhooks))
+
+	for _, w := range conf.Webhooks {
+		if w.Name == "" {
+			return fmt.Errorf("webhook with no name")
+		}
+
+		if _, ok := names[w.Name]; ok {
+			return fmt.Errorf("webhook %s is duplicated", w.Name)
+		}
+		names[w.Name] = struct{}{}
+
+		if _, err := newWebhook(w, conf.RateLimit); err != nil {
+			return fmt.Errorf("webhook %s: %w", w.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// newWebhook is used to parse the webhook config
+func newWebhook(conf *config.Webhook, limit int) (*webhook, error) {
+	if u, err := url.Parse(conf.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid url %s", conf.URL)
+	}
+
+	tmpl, err := parseTemplate(conf.Format, conf.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &webhook{conf: conf, tmpl: tmpl, limit: limit}
+
+	if len(conf.Events) > 0 {
+		w.events = make(map[Event]struct{}, len(conf.Events))
+
+		for _, name := range conf.Events {
+			e, err := EventFromString(name)
+			if err != nil {
+				return nil, err
+			}
+
+			w.events[e] = struct{}{}
+		}
+	}
+
+	return w, nil
+}
+
+func (a *alerter) Notify(alert *Alert) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.duplicated(alert) {
+		logDebug(fmt.Sprintf("duplicated %s alert is not sent: %s", alert.Event, alert.Message), "Notify")
+		return
+	}
+
+	// the alert is marked sent only once queued, so a dropped alert is not deduplicated
+	select {
+	case a.queue <- alert:
+		a.markSent(alert)
+	default:
+		logWarn(fmt.Sprintf("alerts queue is full, %s alert is not sent", alert.Event), "Notify")
+	}
+}
+
+// duplicated is used to check the alert is sent during the dedup window. The mu must be held
+func (a *alerter) duplicated(alert *Alert) bool {
+	last, ok := a.sent[alert.dedupKey()]
+	return ok && alert.Time.Sub(last) < a.dedup
+}
+
+// markSent is used to record the alert send time. The mu must be held
+func (a *alerter) markSent(alert *Alert) {
+	a.sent[alert.dedupKey()] = alert.Time
+
+	// expired keys are dropped, so the map does not grow with the unique keys
+	for k, t := range a.sent {
+		if alert.Time.Sub(t) >= a.dedup {
+			delete(a.sent, k)
+		}
+	}
+}
+
+// run is used to deliver the queued alerts until the alerter is closed
+func (a *alerter) run() {
+	for {
+		select {
+		case <-a.stop:
+			return
+		case alert := <-a.queue:
+			for _, w := range a.webhooks {
+				a.deliver(w, alert)
+			}
+		}
+	}
+}
+
+// deliver is used to render and post the alert payload to the webhook. Alerts of not subscribed events and alerts
+// above the rate limit are skipped
+func (a *alerter) deliver(w *webhook, alert *Alert) {
+	if w.events != nil {
+		if _, ok := w.events[alert.Event]; !ok {
+			return
+		}
+	}
+
+	if !w.allow(alert.Time) {
+		logWarn(fmt.Sprintf("webhook %s rate limit reached, %s alert is not sent", w.conf.Name, alert.Event), "Deliver")
+		return
+	}
+
+	body, err := render(w.tmpl, newPayload(alert, w.conf.RoutingKey))
+	if err != nil {
+		logErr(fmt.Sprintf("err render webhook %s payload: %s", w.conf.Name, err.Error()), "Deliver")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.conf.URL, strings.NewReader(string(body)))
+	if err != nil {
+		logErr(fmt.Sprintf("err create webhook %s request: %s", w.conf.Name, err.Error()), "Deliver")
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := a.http.Do(req)
+	if err != nil {
+		logErr(fmt.Sprintf("err post webhook %s: %s", w.conf.Name, err.Error()), "Deliver")
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		logErr(fmt.Sprintf("webhook %s responded with status %v", w.conf.Name, resp.StatusCode), "Deliver")
+		return
+	}
+
+	logDebug(fmt.Sprintf("%s alert sent to the webhook %s", alert.Event, w.conf.Name), "Deliver")
+}
+
+// allow is used to check the webhook rate limit and count the send. It is used by the delivery goroutine only
+func (w *webhook) allow(now time.Time) bool {
+	if w.limit <= 0 {
+		return true
+	}
+
+	sent := w.sent[:0]
+	for _, t := range w.sent {
+		if now.Sub(t) < rateWindow {
+			sent = append(sent, t)
+		}
+	}
+	w.sent = sent
+
+	if len(w.sent) >= w.limit {
+		return false
+	}
+
+	w.sent = append(w.sent, now)
+
+	return true
+}
+
+func (a *alerter) Close() {
+	close(a.stop)
+}
+
+// current is the alerter used by Notify. Alerts are only logged until it is set
+var current atomic.Pointer[IAlerter]
+
+// SetAlerter is used to set the alerter used by Notify. Alerts are only logged if it is nil
+func SetAlerter(a IAlerter) {
+	if a == nil {
+		current.Store(nil)
+		return
+	}
+
+	current.Store(&a)
+}
+
+// Notify is used to deliver the alert with the current alerter. It is a no-op if no alerter is set
+func Notify(event Event, source string, key string, msg string) {
+	a := current.Load()
+	if a == nil {
+		return
+	}
+
+	(*a).Notify(&Alert{Event: event, Source: source, Key: key, Message: msg, Time: time.Now()})
+}
@@ -0,0 +1,238 @@
+package alerts
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"oracle-flare/config"
+)
+
+// request is a webhook request received by the test server
+type request struct {
+	path string
+	body map[string]interface{}
+}
+
+// newWebhookServer is used to get a local webhook server passing the received requests to the channel
+func newWebhookServer(t *testing.T) (*httptest.Server, chan request) {
+	t.Helper()
+
+	requests := make(chan request, 100)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+
+		body := make(map[string]interface{})
+		if err := json.Unmarshal(b, &body); err != nil {
+			t.Errorf("invalid %s payload %s: %s", r.URL.Path, b, err)
+		}
+
+		requests <- request{path: r.URL.Path, body: body}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, requests
+}
+
+// newTestAlerter is used to get the alerter closed when the test ends
+func newTestAlerter(t *testing.T, conf *config.Alerts) IAlerter {
+	t.Helper()
+
+	a, err := NewAlerter(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(a.Close)
+
+	return a
+}
+
+// receiveUntil is used to get the requests received until the payload with the last message. The alerts are delivered
+// in order, so all requests of the alerts notified before the last one are received
+func receiveUntil(t *testing.T, requests chan request, last string) []request {
+	t.Helper()
+
+	var received []request
+	deadline := time.After(time.Second * 5)
+
+	for {
+		select {
+		case r := <-requests:
+			if r.body["message"] == last {
+				return received
+			}
+
+			received = append(received, r)
+		case <-deadline:
+			t.Fatalf("no %s alert received, received %v", last, received)
+		}
+	}
+}
+
+// messages is used to get the messages of the json payloads
+func messages(requests []request) []interface{} {
+	msgs := make([]interface{}, 0, len(requests))
+	for _, r := range requests {
+		msgs = append(msgs, r.body["message"])
+	}
+
+	return msgs
+}
+
+func TestNotifyDedup(t *testing.T) {
+	srv, requests := newWebhookServer(t)
+	a := newTestAlerter(t, &config.Alerts{
+		Webhooks: []*config.Webhook{{Name: "json", URL: srv.URL}},
+		DedupSec: 600,
+	})
+
+	now := time.Now()
+	a.Notify(&Alert{Event: MissedReveal, Source: "sender", Key: "BTC", Message: "first", Time: now})
+	a.Notify(&Alert{Event: MissedReveal, Source: "sender", Key: "BTC", Message: "duplicated", Time: now.Add(time.Minute)})
+	a.Notify(&Alert{Event: MissedReveal, Source: "sender", Key: "ETH", Message: "other key", Time: now})
+	a.Notify(&Alert{Event: TxReverted, Source: "sender", Key: "BTC", Message: "other event", Time: now})
+	a.Notify(&Alert{Event: MissedReveal, Source: "sender", Key: "BTC", Message: "after window",
+		Time: now.Add(time.Second * 600)})
+	a.Notify(&Alert{Event: MissedCommit, Message: "last", Time: now})
+
+	got := messages(receiveUntil(t, requests, "last"))
+	want := []interface{}{"first", "other key", "other event", "after window"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("messages: got %v, want %v", got, want)
+	}
+}
+
+func TestNotifyQueueFull(t *testing.T) {
+	// the delivery is not run, so the queue is only drained by the test
+	a := &alerter{
+		dedup: time.Minute,
+		sent:  make(map[string]time.Time),
+		queue: make(chan *Alert, 1),
+	}
+
+	now := time.Now()
+	queued := &Alert{Event: MissedCommit, Key: "queued", Time: now}
+	dropped := &Alert{Event: MissedCommit, Key: "dropped", Time: now}
+
+	a.Notify(queued)
+	a.Notify(dropped)
+
+	if got := <-a.queue; got != queued {
+		t.Fatalf("queued alert: got %+v", got)
+	}
+
+	// the dropped alert was not sent, so it is not deduplicated
+	a.Notify(dropped)
+
+	select {
+	case got := <-a.queue:
+		if got != dropped {
+			t.Errorf("queued alert: got %+v, want %+v", got, dropped)
+		}
+	default:
+		t.Error("alert dropped on the full queue is deduplicated")
+	}
+}
+
+func TestWebhookRateLimit(t *testing.T) {
+	srv, requests := newWebhookServer(t)
+	a := newTestAlerter(t, &config.Alerts{
+		Webhooks:  []*config.Webhook{{Name: "json", URL: srv.URL}},
+		RateLimit: 2,
+	})
+
+	now := time.Now()
+	for _, key := range []string{"1", "2", "3", "4"} {
+		a.Notify(&Alert{Event: MissedCommit, Key: key, Message: key, Time: now})
+	}
+	// the last alert is in the next rate window
+	a.Notify(&Alert{Event: MissedCommit, Key: "last", Message: "last", Time: now.Add(rateWindow)})
+
+	got := messages(receiveUntil(t, requests, "last"))
+	want := []interface{}{"1", "2"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("messages: got %v, want %v", got, want)
+	}
+}
+
+func TestWebhookEvents(t *testing.T) {
+	srv, requests := newWebhookServer(t)
+	a := newTestAlerter(t, &config.Alerts{
+		Webhooks: []*config.Webhook{
+			{Name: "reveals", URL: srv.URL + "/reveals", Events: []string{"missed_reveal"}},
+			{Name: "all", URL: srv.URL + "/all"},
+		},
+	})
+
+	now := time.Now()
+	a.Notify(&Alert{Event: TxReverted, Message: "reverted", Time: now})
+	a.Notify(&Alert{Event: MissedReveal, Message: "reveal", Time: now})
+	a.Notify(&Alert{Event: LowBalance, Message: "last", Time: now})
+
+	// the last alert is received by the all webhook after the reveals webhook skipped it
+	received := make(map[string][]interface{})
+	for _, r := range receiveUntil(t, requests, "last") {
+		received[r.path] = append(received[r.path], r.body["message"])
+	}
+
+	want := map[string][]interface{}{
+		"/reveals": {"reveal"},
+		"/all":     {"reverted", "reveal"},
+	}
+
+	if !reflect.DeepEqual(received, want) {
+		t.Errorf("received: got %v, want %v", received, want)
+	}
+}
+
+func TestWebhookPayloads(t *testing.T) {
+	srv, requests := newWebhookServer(t)
+	a := newTestAlerter(t, &config.Alerts{
+		Webhooks: []*config.Webhook{
+			{Name: "slack", URL: srv.URL + "/slack", Format: "slack"},
+			{Name: "pagerduty", URL: srv.URL + "/pagerduty", Format: "pagerduty", RoutingKey: "routing"},
+			{Name: "template", URL: srv.URL + "/template",
+				Template: `{"alert":{{json .Event}},"token":{{json .Key}},"text":{{json .Message}}}`},
+		},
+	})
+
+	alertTime := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+	a.Notify(&Alert{Event: MissedReveal, Source: "Service-Sender", Key: "BTC", Message: "reveal failed",
+		Time: alertTime})
+
+	want := map[string]map[string]interface{}{
+		"/slack": {"text": "[missed_reveal] Service-Sender: reveal failed"},
+		"/pagerduty": {
+			"routing_key":  "routing",
+			"event_action": "trigger",
+			"dedup_key":    "missed_reveal/Service-Sender/BTC",
+			"payload": map[string]interface{}{
+				"summary":   "reveal failed",
+				"source":    "Service-Sender",
+				"severity":  "error",
+				"component": "oracle-flare",
+				"class":     "missed_reveal",
+				"timestamp": "2024-05-01T12:30:00Z",
+			},
+		},
+		"/template": {"alert": "missed_reveal", "token": "BTC", "text": "reveal failed"},
+	}
+
+	deadline := time.After(time.Second * 5)
+	for range want {
+		select {
+		case r := <-requests:
+			if !reflect.DeepEqual(r.body, want[r.path]) {
+				t.Errorf("%s payload: got %v, want %v", r.path, r.body, want[r.path])
+			}
+		case <-deadline:
+			t.Fatal("not all payloads received")
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package alerts
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Event is an alert event type
+type Event int
+
+const (
+	UnknownEvent Event = iota
+	// MissedCommit is a price epoch without the confirmed commit
+	MissedCommit
+	// MissedReveal is a failed reveal of the committed prices
+	MissedReveal
+	// TxReverted is a mined signer transaction with the failed status
+	TxReverted
+	// WhitelistRemoved is a signer removal from the token whitelist
+	WhitelistRemoved
+	// PriceSourceDown is a lost price source connection or no prices from it
+	PriceSourceDown
+	// LowBalance is a signer balance enough for a few price epochs only
+	LowBalance
+	// CircuitBreaker is a commit withheld because of too many rejected prices
+	CircuitBreaker
+	// PriceWithheld is a token price rejected by the validation rules
+	PriceWithheld
+)
+
+var EventStrings = [...]string{
+	UnknownEvent:     "unknown",
+	MissedCommit:     "missed_commit",
+	MissedReveal:     "missed_reveal",
+	TxReverted:       "tx_reverted",
+	WhitelistRemoved: "whitelist_removed",
+	PriceSourceDown:  "price_source_down",
+	LowBalance:       "low_balance",
+	CircuitBreaker:   "circuit_breaker",
+	PriceWithheld:    "price_withheld",
+}
+
+// EventFromString is used to get the event from the given string
+func EventFromString(s string) (Event, error) {
+	for e, name := range EventStrings {
+		if Event(e) != UnknownEvent && strings.EqualFold(name, s) {
+			return Event(e), nil
+		}
+	}
+
+	return UnknownEvent, fmt.Errorf("alert event %s not supported", s)
+}
+
+// String is used to get Event string value
+func (e Event) String() string {
+	return EventStrings[e]
+}
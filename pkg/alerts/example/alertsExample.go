@@ -0,0 +1,49 @@
+package main
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"oracle-flare/config"
+	"oracle-flare/pkg/alerts"
+)
+
+/**
+This is an example of the alerts pkg usage. A local HTTP test server receives the alerts of the json, slack and
+pagerduty formats and prints the payloads. The second missed reveal alert is deduplicated and not sent.
+You can play with the webhook templates, events filters and the dedup and rate limit settings
+*/
+
+func main() {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		log.Printf("received %s: %s", r.URL.Path, body)
+	}))
+	defer srv.Close()
+
+	a, err := alerts.NewAlerter(&config.Alerts{
+		Webhooks: []*config.Webhook{
+			{Name: "json", URL: srv.URL + "/json"},
+			{Name: "slack", URL: srv.URL + "/slack", Format: "slack"},
+			{Name: "pagerduty", URL: srv.URL + "/pagerduty", Format: "pagerduty", RoutingKey: "key",
+				Events: []string{"missed_reveal"}},
+		},
+		DedupSec:  600,
+		RateLimit: 10,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer a.Close()
+
+	alerts.SetAlerter(a)
+
+	alerts.Notify(alerts.MissedReveal, "Service-Sender", "", "err reveal for the epochID: 1")
+	alerts.Notify(alerts.MissedReveal, "Service-Sender", "", "err reveal for the epochID: 2")
+	alerts.Notify(alerts.PriceWithheld, "Service-Validator", "BTC", "epochID: 2 BTC price 1 withheld")
+
+	time.Sleep(time.Second)
+}
@@ -0,0 +1,23 @@
+package alerts
+
+import (
+	"fmt"
+
+	"oracle-flare/pkg/logger"
+)
+
+func logWarn(msg string, method string) {
+	logger.Log().WithField("layer", fmt.Sprintf("Alerts-%s", method)).Warning(msg)
+}
+
+func logInfo(msg string, method string) {
+	logger.Log().WithField("layer", fmt.Sprintf("Alerts-%s", method)).Info(msg)
+}
+
+func logErr(msg string, method string) {
+	logger.Log().WithField("layer", fmt.Sprintf("Alerts-%s", method)).Error(msg)
+}
+
+func logDebug(msg string, method string) {
+	logger.Log().WithField("layer", fmt.Sprintf("Alerts-%s", method)).Debug(msg)
+}
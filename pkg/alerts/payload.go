@@ -0,0 +1,83 @@
+package alerts
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"text/template"
+	"time"
+)
+
+// formatTemplates are the payload templates of the webhook formats
+var formatTemplates = map[string]string{
+	"json": `{"event":{{json .Event}},"source":{{json .Source}},"key":{{json .Key}},"message":{{json .Message}},` +
+		`"time":{{json .Time}}}`,
+	"slack": `{"text":{{json (printf "[%s] %s: %s" .Event .Source .Message)}}}`,
+	"pagerduty": `{"routing_key":{{json .RoutingKey}},"event_action":"trigger","dedup_key":{{json .DedupKey}},` +
+		`"payload":{"summary":{{json .Message}},"source":{{json .Source}},"severity":"error","component":"oracle-flare",` +
+		`"class":{{json .Event}},"timestamp":{{json .Time}}}}`,
+}
+
+// payload is the webhook template data
+type payload struct {
+	// Event is the alert event name, e.g. "missed_reveal"
+	Event string
+	// Source is the alerting component, e.g. "Service-Sender"
+	Source string
+	// Key is the alert subject within the event, e.g. the token name. It can be empty
+	Key string
+	// DedupKey is the alert deduplication key: the event, source and key
+	DedupKey string
+	Message  string
+	// Time is the alert time in RFC3339
+	Time string
+	// RoutingKey is the webhook PagerDuty routing key
+	RoutingKey string
+}
+
+// newPayload is used to get the template data of the alert
+func newPayload(a *Alert, routingKey string) *payload {
+	return &payload{
+		Event:      a.Event.String(),
+		Source:     a.Source,
+		Key:        a.Key,
+		DedupKey:   a.dedupKey(),
+		Message:    a.Message,
+		Time:       a.Time.UTC().Format(time.RFC3339),
+		RoutingKey: routingKey,
+	}
+}
+
+// parseTemplate is used to parse the payload template. The format template is used if the template is empty. The
+// json function is used to quote the values
+func parseTemplate(format string, text string) (*template.Template, error) {
+	if text == "" {
+		if format == "" {
+			format = "json"
+		}
+
+		t, ok := formatTemplates[format]
+		if !ok {
+			return nil, fmt.Errorf("webhook format %s not supported", format)
+		}
+
+		text = t
+	}
+
+	return template.New("payload").Funcs(template.FuncMap{
+		"json": func(v interface{}) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+	}).Parse(text)
+}
+
+// render is used to render the alert payload with the template
+func render(t *template.Template, p *payload) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := t.Execute(buf, p); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,86 @@
+package exchanges
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// binanceURL is the Binance public ws api url
+const binanceURL = "wss://stream.binance.com:9443/ws"
+
+// binance is a Binance spot public ws api adapter. Trades of the USDT pairs are used
+type binance struct {
+	// baseURL is the public ws api url
+	baseURL string
+}
+
+// binanceTrade is a Binance trade stream message
+type binanceTrade struct {
+	Event string `json:"e"`
+	// EventTime is declared to not be decoded into the Event, json keys are matched case-insensitive
+	EventTime int64  `json:"E"`
+	Symbol    string `json:"s"`
+	Price     string `json:"p"`
+}
+
+// binanceError is a Binance error message
+type binanceError struct {
+	ID    int `json:"id"`
+	Error *struct {
+		Code int    `json:"code"`
+		Msg  string `json:"msg"`
+	} `json:"error"`
+}
+
+func (b *binance) name() string {
+	return Binance
+}
+
+func (b *binance) url() string {
+	return b.baseURL
+}
+
+func (b *binance) symbol(coin string) (string, string, bool) {
+	if coin == "USDT" {
+		return "", "", false
+	}
+
+	return coin + "USDT", "USDT", true
+}
+
+func (b *binance) subscribeMsgs(symbols []string) []interface{} {
+	streams := make([]string, 0, len(symbols))
+	for _, s := range symbols {
+		streams = append(streams, strings.ToLower(s)+"@trade")
+	}
+
+	// all streams are subscribed with the single message because of the incoming messages rate limit
+	return []interface{}{
+		map[string]interface{}{
+			"method": "SUBSCRIBE",
+			"params": streams,
+			"id":     1,
+		},
+	}
+}
+
+func (b *binance) parse(data []byte) ([]*tick, error) {
+	errMsg := &binanceError{}
+	if err := json.Unmarshal(data, errMsg); err == nil && errMsg.Error != nil {
+		return nil, fmt.Errorf("code %v: %s", errMsg.Error.Code, errMsg.Error.Msg)
+	}
+
+	trade := &binanceTrade{}
+	if err := json.Unmarshal(data, trade); err != nil || trade.Event != "trade" {
+		return nil, nil
+	}
+
+	price, err := strconv.ParseFloat(trade.Price, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s price: %w", trade.Symbol, err)
+	}
+
+	return []*tick{{symbol: trade.Symbol, price: price}}, nil
+}
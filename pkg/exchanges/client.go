@@ -0,0 +1,228 @@
+package exchanges
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"oracle-flare/config"
+	"oracle-flare/pkg/flare/contracts"
+	"oracle-flare/pkg/wsClient"
+)
+
+const (
+	// minFrequency is a min average prices frequency
+	minFrequency = time.Second
+	// minStaleAfter is a min age after which the exchange price is not used for the average
+	minStaleAfter = time.Second * 30
+)
+
+// IExchangesClient is an exchanges pkg interface
+type IExchangesClient interface {
+	// SubscribeCoinAveragePrice is used to connect to the exchanges and stream the average of the latest exchange
+	// prices of the coins each frequencyMS in the same shape as the ws coin_average_price stream. Prices are averaged
+	// and streamed per quote currency of the exchange pairs
+	SubscribeCoinAveragePrice(coins []string, id int, frequencyMS int, v chan *wsClient.CoinAveragePriceStream) error
+	// Unsubscribe is used to stop the subscription with given id and close its exchange connections
+	Unsubscribe(id int) error
+	// UpdateConfig is used to change the exchanges. It is applied to the next subscriptions, running ones keep their
+	// exchanges until they are resubscribed
+	UpdateConfig(conf *config.Exchanges)
+	// Close is used to close the service
+	Close()
+}
+
+// client is an exchanges pkg struct implementing IExchangesClient interface
+type client struct {
+	// conf is the current config. It is read on each subscription
+	conf atomic.Pointer[config.Exchanges]
+
+	// mu guards the subs
+	mu sync.Mutex
+	// subs mapping subscription id to the subscription stop chanel
+	subs map[int]chan struct{}
+
+	stop chan struct{}
+}
+
+// NewClient is used to get new client instance
+func NewClient(conf *config.Exchanges) IExchangesClient {
+	c := &client{
+		subs: make(map[int]chan struct{}),
+		stop: make(chan struct{}),
+	}
+
+	c.conf.Store(conf)
+
+	return c
+}
+
+func (c *client) SubscribeCoinAveragePrice(coins []string, id int, frequencyMS int, v chan *wsClient.CoinAveragePriceStream) error {
+	conf := c.conf.Load()
+
+	urls, err := parseURLs(conf.URLs)
+	if err != nil {
+		return err
+	}
+
+	exs := make([]exchange, 0, len(conf.Names))
+	for _, n := range conf.Names {
+		ex, err := newExchange(n, urls[n])
+		if err != nil {
+			return err
+		}
+		exs = append(exs, ex)
+	}
+
+	if len(exs) == 0 {
+		return fmt.Errorf("no exchanges configured")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	select {
+	case <-c.stop:
+		return fmt.Errorf("client is closed")
+	default:
+	}
+
+	if _, ok := c.subs[id]; ok {
+		return fmt.Errorf("subscription with id %v already exists", id)
+	}
+
+	stop := make(chan struct{})
+	c.subs[id] = stop
+
+	known := make([]string, 0, len(coins))
+	for _, coin := range coins {
+		if contracts.GetTokenIDFromName(coin) == contracts.UnknownToken {
+			logWarn(fmt.Sprintln("unknown coin skipped:", coin), "Subscribe")
+			continue
+		}
+		known = append(known, coin)
+	}
+
+	p := newPrices()
+	// streamed are all subscribed pairs of all exchanges in the coins order
+	streamed := make([]pair, 0, len(known))
+
+	for _, ex := range exs {
+		pairs := make(map[string]pair)
+		for _, coin := range known {
+			if s, quote, ok := ex.symbol(coin); ok {
+				pairs[s] = pair{coin: coin, quote: quote}
+			}
+		}
+
+		if len(pairs) == 0 {
+			continue
+		}
+
+		conn := &connector{ex: ex, pairs: pairs, update: p.update, stop: stop}
+		go conn.run()
+	}
+
+	for _, coin := range known {
+		for _, ex := range exs {
+			if _, quote, ok := ex.symbol(coin); ok && !containsPair(streamed, pair{coin: coin, quote: quote}) {
+				streamed = append(streamed, pair{coin: coin, quote: quote})
+			}
+		}
+	}
+
+	frequency := time.Duration(frequencyMS) * time.Millisecond
+	if frequency < minFrequency {
+		frequency = minFrequency
+	}
+
+	logInfo(fmt.Sprintf("streaming average prices of %v from %v each %v", coins, conf.Names, frequency), "Subscribe")
+	go c.stream(streamed, frequency, p, v, stop)
+
+	return nil
+}
+
+func (c *client) Unsubscribe(id int) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stop, ok := c.subs[id]
+	if !ok {
+		return fmt.Errorf("no subscription with id %v", id)
+	}
+
+	close(stop)
+	delete(c.subs, id)
+
+	return nil
+}
+
+func (c *client) UpdateConfig(conf *config.Exchanges) {
+	logInfo(fmt.Sprintln("exchanges:", conf.Names), "UpdateConfig")
+	c.conf.Store(conf)
+}
+
+func (c *client) Close() {
+	logInfo("closing exchanges client...", "Close")
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	select {
+	case <-c.stop:
+		return
+	default:
+		close(c.stop)
+	}
+
+	for id, stop := range c.subs {
+		close(stop)
+		delete(c.subs, id)
+	}
+}
+
+// stream is used to send the average prices of the pairs each frequency until stop is closed. Prices older than
+// two frequency periods are not used
+func (c *client) stream(pairs []pair, frequency time.Duration, p *prices, v chan *wsClient.CoinAveragePriceStream, stop chan struct{}) {
+	staleAfter := frequency * 2
+	if staleAfter < minStaleAfter {
+		staleAfter = minStaleAfter
+	}
+
+	ticker := time.NewTicker(frequency)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		for _, pr := range pairs {
+			value, n := p.average(pr, staleAfter)
+			if n == 0 {
+				logDebug(fmt.Sprintln("no fresh exchange prices for", pr), "Stream")
+				continue
+			}
+
+			select {
+			case <-stop:
+				return
+			case v <- &wsClient.CoinAveragePriceStream{Coin: pr.coin, Timestamp: int(time.Now().Unix()), Value: value, Quote: pr.quote}:
+			}
+		}
+	}
+}
+
+// containsPair is used to check if the pair is in the pairs
+func containsPair(pairs []pair, p pair) bool {
+	for _, pp := range pairs {
+		if pp == p {
+			return true
+		}
+	}
+
+	return false
+}
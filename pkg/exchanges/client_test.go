@@ -0,0 +1,45 @@
+package exchanges
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"oracle-flare/config"
+	"oracle-flare/pkg/wsClient"
+)
+
+func TestClientAveragePrice(t *testing.T) {
+	coinbaseSrv := newReplayServer(t, recordedFrames(t, Coinbase), 0)
+	krakenSrv := newReplayServer(t, recordedFrames(t, Kraken), 0)
+
+	c := NewClient(&config.Exchanges{
+		Names: []string{Coinbase, Kraken},
+		URLs:  []string{"coinbase=" + coinbaseSrv.wsURL(), "kraken=" + krakenSrv.wsURL()},
+	})
+	defer c.Close()
+
+	v := make(chan *wsClient.CoinAveragePriceStream, 100)
+	if err := c.SubscribeCoinAveragePrice([]string{"BTC"}, 1, 1000, v); err != nil {
+		t.Fatal(err)
+	}
+
+	want := (60318.21 + 60310.2) / 2
+	deadline := time.After(time.Second * 10)
+
+	for {
+		select {
+		case p := <-v:
+			if p.Coin != "BTC" || p.Quote != "USD" {
+				t.Fatalf("unexpected price %+v", p)
+			}
+
+			// the first average can be streamed before both exchanges replied
+			if math.Abs(p.Value-want) < 1e-9 {
+				return
+			}
+		case <-deadline:
+			t.Fatalf("no average price %v received", want)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package exchanges
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// coinbaseURL is the Coinbase public ws api url
+const coinbaseURL = "wss://ws-feed.exchange.coinbase.com"
+
+// coinbase is a Coinbase exchange public ws api adapter. Tickers of the USD pairs are used
+type coinbase struct {
+	// baseURL is the public ws api url
+	baseURL string
+}
+
+// coinbaseMessage is a Coinbase ticker or error message
+type coinbaseMessage struct {
+	Type      string `json:"type"`
+	ProductID string `json:"product_id"`
+	Price     string `json:"price"`
+	Message   string `json:"message"`
+	Reason    string `json:"reason"`
+}
+
+func (c *coinbase) name() string {
+	return Coinbase
+}
+
+func (c *coinbase) url() string {
+	return c.baseURL
+}
+
+func (c *coinbase) symbol(coin string) (string, string, bool) {
+	// USDC is converted to USD 1:1 on Coinbase and has no market
+	if coin == "USDC" {
+		return "", "", false
+	}
+
+	return coin + "-USD", "USD", true
+}
+
+func (c *coinbase) subscribeMsgs(symbols []string) []interface{} {
+	// a not listed product fails the whole subscribe message, so each product is subscribed separately
+	msgs := make([]interface{}, 0, len(symbols))
+	for _, s := range symbols {
+		msgs = append(msgs, map[string]interface{}{
+			"type":        "subscribe",
+			"product_ids": []string{s},
+			"channels":    []string{"ticker"},
+		})
+	}
+
+	return msgs
+}
+
+func (c *coinbase) parse(data []byte) ([]*tick, error) {
+	msg := &coinbaseMessage{}
+	if err := json.Unmarshal(data, msg); err != nil {
+		return nil, nil
+	}
+
+	switch msg.Type {
+	case "error":
+		return nil, fmt.Errorf("%s: %s", msg.Message, msg.Reason)
+	case "ticker":
+		price, err := strconv.ParseFloat(msg.Price, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s price: %w", msg.ProductID, err)
+		}
+
+		return []*tick{{symbol: msg.ProductID, price: price}}, nil
+	default:
+		return nil, nil
+	}
+}
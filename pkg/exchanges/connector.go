@@ -0,0 +1,128 @@
+package exchanges
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"oracle-flare/pkg/internal/backoff"
+)
+
+const (
+	// reconnectMinDelay is a min delay before the reconnect attempt
+	reconnectMinDelay = time.Second
+	// reconnectMaxDelay is a max delay before the reconnect attempt
+	reconnectMaxDelay = time.Minute
+	// writeWait is a time allowed to write a message to the exchange
+	writeWait = time.Second * 10
+	// readWait is a time allowed to read the next message or ping from the exchange. The connection is reconnected
+	// when it is exceeded
+	readWait = time.Minute * 3
+)
+
+// connector is a single exchange ws connection feeding the prices of the subscribed coins
+type connector struct {
+	ex exchange
+	// pairs mapping the exchange pair symbol to the coin and quote pair
+	pairs map[string]pair
+	// update is called for each received pair price
+	update func(p pair, exchange string, price float64)
+
+	stop chan struct{}
+}
+
+// run is used to keep the exchange connection and reconnect with the exponential backoff until stop is closed
+func (c *connector) run() {
+	b := backoff.New(reconnectMinDelay, reconnectMaxDelay)
+
+	for {
+		connected, err := c.listen()
+		if connected {
+			b.Reset()
+		}
+
+		select {
+		case <-c.stop:
+			return
+		default:
+		}
+
+		delay := b.Next()
+		logWarn(fmt.Sprintf("%s connection lost, reconnecting in %v err: %s", c.ex.name(), delay, err.Error()), "Connector")
+
+		select {
+		case <-c.stop:
+			return
+		case <-time.After(delay):
+		}
+	}
+}
+
+// listen is used to dial the exchange, subscribe to all pairs and read the prices. Returns true if the connection was
+// established and the read error
+func (c *connector) listen() (bool, error) {
+	conn, _, err := websocket.DefaultDialer.Dial(c.ex.url(), nil)
+	if err != nil {
+		return false, err
+	}
+
+	// the connection is closed on stop to unblock the reader
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-c.stop:
+		case <-done:
+		}
+		_ = conn.Close()
+	}()
+
+	symbols := make([]string, 0, len(c.pairs))
+	for s := range c.pairs {
+		symbols = append(symbols, s)
+	}
+
+	for _, msg := range c.ex.subscribeMsgs(symbols) {
+		if err := conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
+			return true, err
+		}
+
+		if err := conn.WriteJSON(msg); err != nil {
+			return true, fmt.Errorf("send subscribe: %w", err)
+		}
+	}
+
+	logInfo(fmt.Sprintf("%s connected, subscribed to %v", c.ex.name(), symbols), "Connector")
+
+	conn.SetPingHandler(func(data string) error {
+		if err := conn.SetReadDeadline(time.Now().Add(readWait)); err != nil {
+			return err
+		}
+
+		return conn.WriteControl(websocket.PongMessage, []byte(data), time.Now().Add(writeWait))
+	})
+
+	for {
+		if err := conn.SetReadDeadline(time.Now().Add(readWait)); err != nil {
+			return true, err
+		}
+
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return true, err
+		}
+
+		ticks, err := c.ex.parse(data)
+		if err != nil {
+			logWarn(fmt.Sprintf("error from %s: %s", c.ex.name(), err.Error()), "Connector")
+			continue
+		}
+
+		for _, t := range ticks {
+			if p, ok := c.pairs[t.symbol]; ok {
+				c.update(p, c.ex.name(), t.price)
+			}
+		}
+	}
+}
@@ -0,0 +1,334 @@
+package exchanges
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// recordedFrames is used to read the recorded exchange ws frames from the testdata, one frame per line
+func recordedFrames(t *testing.T, exchange string) [][]byte {
+	t.Helper()
+
+	f, err := os.Open(filepath.Join("testdata", exchange+".jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var frames [][]byte
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			frames = append(frames, []byte(line))
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	return frames
+}
+
+// replayServer is a local ws server replaying the recorded frames to each connection after the first subscribe
+// message. The first dropConns connections are closed after the replay
+type replayServer struct {
+	*httptest.Server
+	frames    [][]byte
+	dropConns int32
+
+	conns      atomic.Int32
+	subscribes chan []byte
+}
+
+func newReplayServer(t *testing.T, frames [][]byte, dropConns int32) *replayServer {
+	t.Helper()
+
+	s := &replayServer{frames: frames, dropConns: dropConns, subscribes: make(chan []byte, 100)}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *replayServer) handle(w http.ResponseWriter, r *http.Request) {
+	conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	n := s.conns.Add(1)
+
+	_, msg, err := conn.ReadMessage()
+	if err != nil {
+		return
+	}
+	s.subscribes <- msg
+
+	for _, f := range s.frames {
+		if err := conn.WriteMessage(websocket.TextMessage, f); err != nil {
+			return
+		}
+	}
+
+	if n <= s.dropConns {
+		return
+	}
+
+	// the connection is kept until the client closes it
+	for {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
+
+// wsURL is used to get the ws url of the server
+func (s *replayServer) wsURL() string {
+	return "ws" + strings.TrimPrefix(s.URL, "http")
+}
+
+// update is a received connector price
+type update struct {
+	pair  pair
+	price float64
+}
+
+// runConnector is used to run the exchange connector for the coins until the test ends
+func runConnector(t *testing.T, name string, url string, coins []string) chan update {
+	t.Helper()
+
+	ex, err := newExchange(name, url)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pairs := make(map[string]pair)
+	for _, coin := range coins {
+		if s, quote, ok := ex.symbol(coin); ok {
+			pairs[s] = pair{coin: coin, quote: quote}
+		}
+	}
+
+	updates := make(chan update, 100)
+	stop := make(chan struct{})
+	t.Cleanup(func() { close(stop) })
+
+	c := &connector{
+		ex:    ex,
+		pairs: pairs,
+		update: func(p pair, exchange string, price float64) {
+			updates <- update{pair: p, price: price}
+		},
+		stop: stop,
+	}
+	go c.run()
+
+	return updates
+}
+
+// receive is used to wait for n updates
+func receive(t *testing.T, updates chan update, n int, timeout time.Duration) []update {
+	t.Helper()
+
+	received := make([]update, 0, n)
+	deadline := time.After(timeout)
+
+	for len(received) < n {
+		select {
+		case u := <-updates:
+			received = append(received, u)
+		case <-deadline:
+			t.Fatalf("received %v of %v updates: %v", len(received), n, received)
+		}
+	}
+
+	return received
+}
+
+func TestParseRecordedFrames(t *testing.T) {
+	tests := []struct {
+		exchange string
+		ticks    []tick
+		errs     int
+	}{
+		{
+			exchange: Binance,
+			ticks:    []tick{{"BTCUSDT", 60321.45}, {"ETHUSDT", 3012.18}, {"BTCUSDT", 60322.01}},
+			errs:     1,
+		},
+		{
+			exchange: Coinbase,
+			ticks:    []tick{{"BTC-USD", 60318.21}, {"ETH-USD", 3011.9}},
+			errs:     1,
+		},
+		{
+			exchange: Kraken,
+			ticks:    []tick{{"BTC/USD", 60310.2}, {"ETH/USD", 3011.55}},
+			errs:     1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.exchange, func(t *testing.T) {
+			ex, err := newExchange(tt.exchange, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var ticks []tick
+			errs := 0
+
+			for _, f := range recordedFrames(t, tt.exchange) {
+				parsed, err := ex.parse(f)
+				if err != nil {
+					errs++
+					continue
+				}
+
+				for _, tk := range parsed {
+					ticks = append(ticks, *tk)
+				}
+			}
+
+			if errs != tt.errs {
+				t.Errorf("errors: got %v, want %v", errs, tt.errs)
+			}
+
+			if len(ticks) != len(tt.ticks) {
+				t.Fatalf("ticks: got %v, want %v", ticks, tt.ticks)
+			}
+
+			for i := range ticks {
+				if ticks[i] != tt.ticks[i] {
+					t.Errorf("tick %v: got %v, want %v", i, ticks[i], tt.ticks[i])
+				}
+			}
+		})
+	}
+}
+
+func TestConnectorReplay(t *testing.T) {
+	tests := []struct {
+		exchange  string
+		subscribe string
+		prices    map[pair]float64
+	}{
+		{
+			exchange:  Binance,
+			subscribe: "btcusdt@trade",
+			prices: map[pair]float64{
+				{coin: "BTC", quote: "USDT"}: 60322.01,
+				{coin: "ETH", quote: "USDT"}: 3012.18,
+			},
+		},
+		{
+			exchange:  Coinbase,
+			subscribe: `"ticker"`,
+			prices: map[pair]float64{
+				{coin: "BTC", quote: "USD"}: 60318.21,
+				{coin: "ETH", quote: "USD"}: 3011.9,
+			},
+		},
+		{
+			exchange:  Kraken,
+			subscribe: `"ticker"`,
+			prices: map[pair]float64{
+				{coin: "BTC", quote: "USD"}: 60310.2,
+				{coin: "ETH", quote: "USD"}: 3011.55,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.exchange, func(t *testing.T) {
+			frames := recordedFrames(t, tt.exchange)
+			srv := newReplayServer(t, frames, 0)
+
+			ticks := 0
+			ex, _ := newExchange(tt.exchange, "")
+			for _, f := range frames {
+				parsed, _ := ex.parse(f)
+				ticks += len(parsed)
+			}
+
+			updates := runConnector(t, tt.exchange, srv.wsURL(), []string{"BTC", "ETH"})
+
+			last := make(map[pair]float64)
+			for _, u := range receive(t, updates, ticks, time.Second*5) {
+				last[u.pair] = u.price
+			}
+
+			for p, want := range tt.prices {
+				if last[p] != want {
+					t.Errorf("%v price: got %v, want %v", p, last[p], want)
+				}
+			}
+
+			select {
+			case msg := <-srv.subscribes:
+				if !strings.Contains(string(msg), tt.subscribe) {
+					t.Errorf("subscribe message %s does not contain %s", msg, tt.subscribe)
+				}
+			default:
+				t.Error("no subscribe message received")
+			}
+		})
+	}
+}
+
+func TestConnectorReconnect(t *testing.T) {
+	frames := recordedFrames(t, Binance)
+	// the first connection is dropped by the server after the replay
+	srv := newReplayServer(t, frames, 1)
+
+	updates := runConnector(t, Binance, srv.wsURL(), []string{"BTC", "ETH"})
+
+	// 3 trades are replayed on each connection
+	receive(t, updates, 6, reconnectMinDelay*2+time.Second*5)
+
+	if n := srv.conns.Load(); n != 2 {
+		t.Errorf("connections: got %v, want 2", n)
+	}
+
+	if n := len(srv.subscribes); n != 2 {
+		t.Errorf("subscribe messages: got %v, want 2", n)
+	}
+}
+
+func TestURLs(t *testing.T) {
+	urls, err := parseURLs([]string{"binance=ws://localhost:8080/ws"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ex, err := newExchange(Binance, urls[Binance])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ex.url() != "ws://localhost:8080/ws" {
+		t.Errorf("binance url: got %s", ex.url())
+	}
+
+	ex, _ = newExchange(Kraken, urls[Kraken])
+	if ex.url() != krakenURL {
+		t.Errorf("kraken url: got %s, want default %s", ex.url(), krakenURL)
+	}
+
+	for _, invalid := range []string{"binance", "okx=ws://localhost", "binance=http://localhost", "binance="} {
+		if err := CheckURLs([]string{invalid}); err == nil {
+			t.Errorf("%s: error expected", invalid)
+		}
+	}
+}
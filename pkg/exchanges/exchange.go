@@ -0,0 +1,105 @@
+package exchanges
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// exchange is an exchange public ws api adapter
+type exchange interface {
+	// name is used to get the exchange name
+	name() string
+	// url is used to get the public ws api url
+	url() string
+	// symbol is used to get the exchange pair symbol and the quote currency for the coin. Returns false if the coin is
+	// not supported
+	symbol(coin string) (string, string, bool)
+	// subscribeMsgs is used to get the subscribe messages for the pair symbols
+	subscribeMsgs(symbols []string) []interface{}
+	// parse is used to get the prices from the message. Returns an error if the message is an error from the exchange
+	parse(data []byte) ([]*tick, error)
+}
+
+// pair is a coin and quote currency pair
+type pair struct {
+	coin  string
+	quote string
+}
+
+// String is used to get the pair string value
+func (p pair) String() string {
+	return p.coin + "/" + p.quote
+}
+
+// tick is a pair symbol price received from the exchange
+type tick struct {
+	symbol string
+	price  float64
+}
+
+// Exchange names
+const (
+	Binance  = "binance"
+	Coinbase = "coinbase"
+	Kraken   = "kraken"
+)
+
+// CheckName is used to check if the exchange is supported
+func CheckName(name string) error {
+	_, err := newExchange(name, "")
+	return err
+}
+
+// CheckURLs is used to check the exchanges public ws api urls overrides
+func CheckURLs(urls []string) error {
+	_, err := parseURLs(urls)
+	return err
+}
+
+// newExchange is used to get the exchange adapter by the name. The exchange public ws api url is used if url is empty
+func newExchange(name string, url string) (exchange, error) {
+	switch name {
+	case Binance:
+		return &binance{baseURL: urlOrDefault(url, binanceURL)}, nil
+	case Coinbase:
+		return &coinbase{baseURL: urlOrDefault(url, coinbaseURL)}, nil
+	case Kraken:
+		return &kraken{baseURL: urlOrDefault(url, krakenURL)}, nil
+	default:
+		return nil, fmt.Errorf("exchange %s not supported", name)
+	}
+}
+
+// urlOrDefault is used to get the url or the default url if it is empty
+func urlOrDefault(url string, def string) string {
+	if url == "" {
+		return def
+	}
+
+	return url
+}
+
+// parseURLs is used to parse the NAME=URL urls overrides to the urls mapped by the exchange names
+func parseURLs(urls []string) (map[string]string, error) {
+	parsed := make(map[string]string, len(urls))
+
+	for _, v := range urls {
+		name, u, ok := strings.Cut(v, "=")
+		if !ok || name == "" || u == "" {
+			return nil, fmt.Errorf("invalid exchange url %s, NAME=URL expected", v)
+		}
+
+		if err := CheckName(name); err != nil {
+			return nil, err
+		}
+
+		if pu, err := url.Parse(u); err != nil || (pu.Scheme != "ws" && pu.Scheme != "wss") || pu.Host == "" {
+			return nil, fmt.Errorf("invalid exchange %s url %s", name, u)
+		}
+
+		parsed[name] = u
+	}
+
+	return parsed, nil
+}
@@ -0,0 +1,76 @@
+package exchanges
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// krakenURL is the Kraken public ws api url
+const krakenURL = "wss://ws.kraken.com/v2"
+
+// kraken is a Kraken v2 public ws api adapter. Tickers of the USD pairs are used
+type kraken struct {
+	// baseURL is the public ws api url
+	baseURL string
+}
+
+// krakenMessage is a Kraken v2 ticker or method response message
+type krakenMessage struct {
+	Channel string `json:"channel"`
+	Data    []struct {
+		Symbol string  `json:"symbol"`
+		Last   float64 `json:"last"`
+	} `json:"data"`
+
+	Method  string `json:"method"`
+	Success *bool  `json:"success"`
+	Error   string `json:"error"`
+}
+
+func (k *kraken) name() string {
+	return Kraken
+}
+
+func (k *kraken) url() string {
+	return k.baseURL
+}
+
+func (k *kraken) symbol(coin string) (string, string, bool) {
+	return coin + "/USD", "USD", true
+}
+
+func (k *kraken) subscribeMsgs(symbols []string) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"method": "subscribe",
+			"params": map[string]interface{}{
+				"channel": "ticker",
+				"symbol":  symbols,
+			},
+		},
+	}
+}
+
+func (k *kraken) parse(data []byte) ([]*tick, error) {
+	msg := &krakenMessage{}
+	if err := json.Unmarshal(data, msg); err != nil {
+		return nil, nil
+	}
+
+	if msg.Success != nil && !*msg.Success {
+		return nil, fmt.Errorf("%s: %s", msg.Method, msg.Error)
+	}
+
+	if msg.Channel != "ticker" {
+		return nil, nil
+	}
+
+	ticks := make([]*tick, 0, len(msg.Data))
+	for _, d := range msg.Data {
+		if d.Last > 0 {
+			ticks = append(ticks, &tick{symbol: d.Symbol, price: d.Last})
+		}
+	}
+
+	return ticks, nil
+}
@@ -0,0 +1,27 @@
+package exchanges
+
+import (
+	"fmt"
+
+	"oracle-flare/pkg/logger"
+)
+
+//func logFatal(msg string, method string) {
+//	logger.Log().WithField("layer", fmt.Sprintf("Exchanges-%s", method)).Fatal(msg)
+//}
+
+func logWarn(msg string, method string) {
+	logger.Log().WithField("layer", fmt.Sprintf("Exchanges-%s", method)).Warning(msg)
+}
+
+func logInfo(msg string, method string) {
+	logger.Log().WithField("layer", fmt.Sprintf("Exchanges-%s", method)).Info(msg)
+}
+
+func logErr(msg string, method string) {
+	logger.Log().WithField("layer", fmt.Sprintf("Exchanges-%s", method)).Error(msg)
+}
+
+func logDebug(msg string, method string) {
+	logger.Log().WithField("layer", fmt.Sprintf("Exchanges-%s", method)).Debug(msg)
+}
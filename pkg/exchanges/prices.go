@@ -0,0 +1,63 @@
+package exchanges
+
+import (
+	"sync"
+	"time"
+)
+
+// exchangePrice is the latest pair price received from the exchange
+type exchangePrice struct {
+	value float64
+	at    time.Time
+}
+
+// prices is the latest pair prices of all exchanges
+type prices struct {
+	mu sync.Mutex
+	// latest mapping pair to the exchange name to the latest price
+	latest map[pair]map[string]*exchangePrice
+}
+
+// newPrices is used to get new prices instance
+func newPrices() *prices {
+	return &prices{
+		latest: make(map[pair]map[string]*exchangePrice),
+	}
+}
+
+// update is used to store the latest pair price of the exchange
+func (p *prices) update(pr pair, exchange string, value float64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.latest[pr]; !ok {
+		p.latest[pr] = make(map[string]*exchangePrice)
+	}
+
+	p.latest[pr][exchange] = &exchangePrice{value: value, at: time.Now()}
+}
+
+// average is used to get the average of the pair prices not older than staleAfter and the number of used exchanges.
+// Prices in different quote currencies are never averaged together
+func (p *prices) average(pr pair, staleAfter time.Duration) (float64, int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	sum := 0.0
+	n := 0
+
+	for _, ep := range p.latest[pr] {
+		if time.Since(ep.at) > staleAfter {
+			continue
+		}
+
+		sum += ep.value
+		n++
+	}
+
+	if n == 0 {
+		return 0, 0
+	}
+
+	return sum / float64(n), n
+}
@@ -0,0 +1,257 @@
+package flare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/params"
+
+	"oracle-flare/config"
+	"oracle-flare/pkg/alerts"
+	"oracle-flare/pkg/metrics"
+)
+
+// ErrPaused is returned by CommitPrices while the commits are paused because of the low signer balance
+var ErrPaused = errors.New("submission paused: low signer balance")
+
+// submissionsPerEpoch is a number of the signer transactions per price epoch: the commit and the reveal. It is used to
+// estimate the cost per epoch until it is observed
+const submissionsPerEpoch = 2
+
+// BalanceStatus is the signer balance monitoring status
+type BalanceStatus struct {
+	// Balance is the signer balance in wei
+	Balance *big.Int
+	// CostPerEpoch is the observed cost per price epoch in wei. It is estimated by the gas settings until observed
+	CostPerEpoch *big.Int
+	// RemainingEpochs is the estimated number of the price epochs the balance is enough for
+	RemainingEpochs int64
+	// Paused is true while the commits are paused because of the low balance
+	Paused bool
+}
+
+// balanceMonitor is used to read the signer balance periodically and estimate the remaining epochs by the balance
+// decrease between the price epochs. Top-ups are not counted as the cost
+type balanceMonitor struct {
+	f *flare
+	// conf is the monitoring configs. It can be changed at runtime, the monitoring is disabled with 0 interval
+	conf atomic.Pointer[config.Balance]
+	// changed notifies the monitor goroutine that the conf is changed
+	changed chan struct{}
+	// native is the native token symbol used in the logs
+	native string
+
+	// lastBalance and lastEpoch are the last balance sample, cost is the observed cost per epoch moving average.
+	// They are used by the monitor goroutine only
+	lastBalance *big.Int
+	lastEpoch   *big.Int
+	cost        *big.Int
+
+	status atomic.Pointer[BalanceStatus]
+	paused atomic.Bool
+}
+
+// newBalanceMonitor is used to get new balanceMonitor instance
+func newBalanceMonitor(f *flare, conf *config.Balance, native string) *balanceMonitor {
+	m := &balanceMonitor{
+		f:       f,
+		changed: make(chan struct{}, 1),
+		native:  native,
+	}
+
+	m.setConfig(conf)
+
+	return m
+}
+
+// setConfig is used to change the monitoring configs. The monitor goroutine applies them at once
+func (m *balanceMonitor) setConfig(conf *config.Balance) {
+	if conf == nil {
+		conf = &config.Balance{}
+	}
+	m.conf.Store(conf)
+
+	select {
+	case m.changed <- struct{}{}:
+	default:
+	}
+}
+
+// run is used to check the balance on each interval until the flare is closed. The interval is restarted on each
+// config change
+func (m *balanceMonitor) run() {
+	var ticker *time.Ticker
+	defer func() {
+		if ticker != nil {
+			ticker.Stop()
+		}
+	}()
+
+	for {
+		var tick <-chan time.Time
+		if ticker != nil {
+			tick = ticker.C
+		}
+
+		select {
+		case <-m.f.stop:
+			return
+		case <-m.changed:
+			if ticker != nil {
+				ticker.Stop()
+				ticker = nil
+			}
+
+			interval := time.Duration(m.conf.Load().IntervalSec) * time.Second
+			if interval <= 0 {
+				m.disable()
+				continue
+			}
+
+			ticker = time.NewTicker(interval)
+			m.check()
+		case <-tick:
+			m.check()
+		}
+	}
+}
+
+// disable is used to clear the status and resume the commits paused by the disabled monitoring
+func (m *balanceMonitor) disable() {
+	m.status.Store(nil)
+	metrics.DeleteBalance(m.f.conf.ChainID, m.f.SignerAddress().Hex())
+
+	if m.paused.Swap(false) {
+		logInfo("signer balance monitoring is disabled, commits are resumed", "Balance", m.f.fields())
+	}
+}
+
+// check is used to read the balance, update the observed cost and apply the thresholds
+func (m *balanceMonitor) check() {
+	balance, err := m.f.provider.BalanceAt(context.Background(), m.f.SignerAddress(), nil)
+	if err != nil {
+		logWarn(fmt.Sprintln("err get signer balance:", err.Error()), "Balance", m.f.fields())
+		return
+	}
+
+	epoch, err := m.f.GetCurrentPriceEpochData()
+	if err != nil {
+		logWarn(fmt.Sprintln("err get price epoch:", err.Error()), "Balance", m.f.fields())
+		return
+	}
+
+	m.observe(balance, epoch.EpochID)
+
+	cost := m.cost
+	if cost == nil {
+		if cost, err = m.estimateCost(); err != nil {
+			logWarn(fmt.Sprintln("err estimate cost per epoch:", err.Error()), "Balance", m.f.fields())
+			return
+		}
+	}
+
+	status := &BalanceStatus{Balance: balance, CostPerEpoch: cost, RemainingEpochs: -1}
+	if cost.Sign() > 0 {
+		status.RemainingEpochs = new(big.Int).Div(balance, cost).Int64()
+	}
+
+	logInfo(fmt.Sprintf("signer balance: %s %s cost per epoch: %s %s remaining epochs: %v",
+		formatNative(balance), m.native, formatNative(cost), m.native, status.RemainingEpochs), "Balance", m.f.fields())
+
+	status.Paused = m.applyThresholds(status)
+	m.status.Store(status)
+
+	metrics.SetBalance(m.f.conf.ChainID, m.f.SignerAddress().Hex(), nativeUnits(balance), status.RemainingEpochs, status.Paused)
+}
+
+// observe is used to update the cost per epoch moving average with the balance decrease since the last sample. The
+// sample is taken once per price epoch, a balance increase is a top-up and only resets the sample
+func (m *balanceMonitor) observe(balance *big.Int, epochID *big.Int) {
+	if m.lastBalance != nil && epochID.Cmp(m.lastEpoch) <= 0 {
+		return
+	}
+
+	if m.lastBalance != nil && balance.Cmp(m.lastBalance) < 0 {
+		epochs := new(big.Int).Sub(epochID, m.lastEpoch)
+		sample := new(big.Int).Div(new(big.Int).Sub(m.lastBalance, balance), epochs)
+
+		if m.cost == nil {
+			m.cost = sample
+		} else {
+			// cost = (3 * cost + sample) / 4
+			m.cost = new(big.Int).Div(new(big.Int).Add(new(big.Int).Mul(m.cost, big.NewInt(3)), sample), big.NewInt(4))
+		}
+	}
+
+	m.lastBalance = balance
+	m.lastEpoch = epochID
+}
+
+// estimateCost is used to estimate the cost per epoch by the gas settings. The config default gas limit is used if it
+// is estimated by the rpc provider
+func (m *balanceMonitor) estimateCost() (*big.Int, error) {
+	gasLimit := m.f.gasLimit.Load()
+	if gasLimit == 0 {
+		gasLimit = config.DefaultGasLimit
+	}
+
+	gasPrice := m.f.gasPrice.Load()
+	if gasPrice == nil {
+		price, err := m.f.provider.SuggestGasPrice(context.Background())
+		if err != nil {
+			return nil, err
+		}
+
+		gasPrice = price
+	}
+
+	cost := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gasLimit))
+
+	return cost.Mul(cost, big.NewInt(submissionsPerEpoch)), nil
+}
+
+// applyThresholds is used to warn the low balance and pause or resume the commits. Returns true if the commits are
+// paused
+func (m *balanceMonitor) applyThresholds(status *BalanceStatus) bool {
+	remaining := status.RemainingEpochs
+	if remaining < 0 {
+		return m.paused.Load()
+	}
+
+	conf := m.conf.Load()
+
+	// topUp is the balance needed to submit for the warning threshold epochs
+	topUp := new(big.Int).Sub(new(big.Int).Mul(status.CostPerEpoch, big.NewInt(int64(conf.WarnEpochs))), status.Balance)
+
+	switch {
+	case conf.PauseEpochs > 0 && remaining < int64(conf.PauseEpochs):
+		if !m.paused.Swap(true) {
+			logAlert(alerts.LowBalance, "pause", fmt.Sprintf("signer balance is enough for %v epochs only, commits are paused until it is topped up "+
+				"with at least %s %s, pending reveals are still sent", remaining, formatNative(topUp), m.native), "Balance", m.f.fields())
+		}
+	case m.paused.Swap(false):
+		logInfo(fmt.Sprintf("signer balance is enough for %v epochs, commits are resumed", remaining), "Balance", m.f.fields())
+	}
+
+	if remaining < int64(conf.WarnEpochs) {
+		logAlert(alerts.LowBalance, "warn", fmt.Sprintf("low signer balance: enough for %v epochs, top up with at least %s %s for %v epochs",
+			remaining, formatNative(topUp), m.native, conf.WarnEpochs), "Balance", m.f.fields())
+	}
+
+	return m.paused.Load()
+}
+
+// formatNative is used to format the wei value in the native token units
+func formatNative(wei *big.Int) string {
+	return new(big.Float).Quo(new(big.Float).SetInt(wei), big.NewFloat(params.Ether)).Text('f', 4)
+}
+
+// nativeUnits is used to convert the wei value to the native token units
+func nativeUnits(wei *big.Int) float64 {
+	units, _ := new(big.Float).Quo(new(big.Float).SetInt(wei), big.NewFloat(params.Ether)).Float64()
+	return units
+}
@@ -0,0 +1,54 @@
+package flare
+
+import (
+	"fmt"
+
+	"oracle-flare/config"
+	"oracle-flare/pkg/flare/contracts"
+
+	// chain adapters are registered on import. A new network is added with its package imported here
+	_ "oracle-flare/pkg/flare/contracts/flareChain"
+	_ "oracle-flare/pkg/flare/contracts/localChain"
+	_ "oracle-flare/pkg/flare/contracts/songbirdChain"
+)
+
+// getChain is used to get the registered chain adapter by the chain ID
+func getChain(id int) (*contracts.ChainAdapter, error) {
+	chain, ok := contracts.GetChain(id)
+	if !ok {
+		return nil, fmt.Errorf("chain id %v not supported, supported: %v", id, contracts.ChainIDs())
+	}
+
+	return chain, nil
+}
+
+// ConfigProtocol is used to get the price submission protocol of the config. The chain default protocol is used if it
+// is not set explicitly
+func ConfigProtocol(conf *config.Flare) (Protocol, error) {
+	if conf.Protocol != "" {
+		return ProtocolFromString(conf.Protocol)
+	}
+
+	chain, err := getChain(conf.ChainID)
+	if err != nil {
+		return UnknownProtocol, err
+	}
+
+	return ProtocolFromString(chain.Protocol)
+}
+
+// symbolRules is used to get the FtsoRegistry symbols naming rules of the config. The chain rules are used for the
+// values which are not set explicitly
+func symbolRules(conf *config.Flare, chain *contracts.ChainAdapter) (string, string) {
+	prefix, native := chain.SymbolPrefix, chain.NativeSymbol
+
+	if conf.SymbolPrefix != "" {
+		prefix = conf.SymbolPrefix
+	}
+
+	if conf.NativeSymbol != "" {
+		native = conf.NativeSymbol
+	}
+
+	return prefix, native
+}
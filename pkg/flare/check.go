@@ -0,0 +1,245 @@
+package flare
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/params"
+
+	"oracle-flare/config"
+	"oracle-flare/pkg/flare/contracts"
+	"oracle-flare/pkg/flare/contracts/ftsov2"
+)
+
+// checkTimeout is a max time of the single check rpc request
+const checkTimeout = time.Second * 10
+
+// CheckResult is a single config check result
+type CheckResult struct {
+	// Name is the checked config value
+	Name string
+	// Detail is the check details on success
+	Detail string
+	// Err is the check error. The check is passed if it is nil
+	Err error
+	// Skipped is true if the check is not run because the checks it depends on are failed
+	Skipped bool
+}
+
+// CheckConfig is used to check the flare config against the blockchain: the chain ID is supported and matches the rpc
+// providers, the registry contract is deployed, the signer key is valid and has balance and the tokens are supported
+// by the FtsoRegistry or the FTSOv2 feeds. Nothing is fatal, all check results are returned
+func CheckConfig(conf *config.Flare, tokens []string) []*CheckResult {
+	res := make([]*CheckResult, 0)
+	add := func(name string, detail string, err error) bool {
+		res = append(res, &CheckResult{Name: name, Detail: detail, Err: err})
+		return err == nil
+	}
+	skip := func(names ...string) {
+		for _, n := range names {
+			res = append(res, &CheckResult{Name: n, Skipped: true})
+		}
+	}
+
+	chain, err := getChain(conf.ChainID)
+	if err != nil {
+		add("flare.chainid", "", err)
+	} else {
+		add("flare.chainid", fmt.Sprintf("%v (%s)", chain.ID, chain), nil)
+	}
+
+	var signer common.Address
+	pk, err := crypto.HexToECDSA(conf.SignerPK)
+	switch {
+	case conf.SignerPK == "":
+		add("flare.signerpk", "", fmt.Errorf("no signer private key"))
+	case err != nil:
+		add("flare.signerpk", "", fmt.Errorf("invalid private key: %w", err))
+	default:
+		signer = crypto.PubkeyToAddress(pk.PublicKey)
+		add("flare.signerpk", fmt.Sprintf("signer %s", signer.Hex()), nil)
+	}
+
+	provider, err := checkRPC(conf.RpcURL, conf.ChainID)
+	if !add("flare.rpcurl", conf.RpcURL, err) {
+		skip("flare.registrycontractaddress", "signer balance", "tokens")
+		return res
+	}
+	defer provider.Close()
+
+	if conf.WSRpcURL != "" {
+		wsProvider, err := checkRPC(conf.WSRpcURL, conf.ChainID)
+		if add("flare.wsrpcurl", conf.WSRpcURL, err) {
+			wsProvider.Close()
+		}
+	}
+
+	registry := common.HexToAddress(conf.RegistryContractAddress)
+	registryOK := add("flare.registrycontractaddress", registry.Hex(), checkCode(provider, conf.RegistryContractAddress))
+
+	if signer == (common.Address{}) {
+		skip("signer balance")
+	} else {
+		add(checkBalance(provider, signer))
+	}
+
+	if !registryOK || chain == nil {
+		skip("tokens")
+		return res
+	}
+
+	add(checkTokens(provider, conf, chain, tokens))
+
+	return res
+}
+
+// checkRPC is used to dial the rpc provider and check its chain ID
+func checkRPC(url string, chainID int) (*ethclient.Client, error) {
+	if url == "" {
+		return nil, fmt.Errorf("no url")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+	defer cancel()
+
+	provider, err := ethclient.DialContext(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("dial: %w", err)
+	}
+
+	id, err := provider.ChainID(ctx)
+	if err != nil {
+		provider.Close()
+		return nil, fmt.Errorf("get chain id: %w", err)
+	}
+
+	if id.Int64() != int64(chainID) {
+		provider.Close()
+		return nil, fmt.Errorf("provider chain id %v does not match the configured %v", id, chainID)
+	}
+
+	return provider, nil
+}
+
+// checkCode is used to check the contract is deployed at the address
+func checkCode(provider *ethclient.Client, address string) error {
+	if !common.IsHexAddress(address) {
+		return fmt.Errorf("invalid address %s", address)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+	defer cancel()
+
+	code, err := provider.CodeAt(ctx, common.HexToAddress(address), nil)
+	if err != nil {
+		return fmt.Errorf("get code: %w", err)
+	}
+
+	if len(code) == 0 {
+		return fmt.Errorf("no contract code at %s", address)
+	}
+
+	return nil
+}
+
+// checkBalance is used to check the signer has balance to pay for the transactions
+func checkBalance(provider *ethclient.Client, signer common.Address) (string, string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+	defer cancel()
+
+	balance, err := provider.BalanceAt(ctx, signer, nil)
+	if err != nil {
+		return "signer balance", "", fmt.Errorf("get balance: %w", err)
+	}
+
+	value, _ := new(big.Float).Quo(new(big.Float).SetInt(balance), big.NewFloat(params.Ether)).Float64()
+	if balance.Sign() == 0 {
+		return "signer balance", "", fmt.Errorf("%s has no balance to pay for the transactions", signer.Hex())
+	}
+
+	return "signer balance", fmt.Sprintf("%.4f", value), nil
+}
+
+// checkTokens is used to check the tokens are supported by the FtsoRegistry
+func checkTokens(provider *ethclient.Client, conf *config.Flare, chain *contracts.ChainAdapter, tokens []string) (string, string, error) {
+	f := &flare{conf: conf, provider: provider, chain: chain, tokens: contracts.NewTokenTable()}
+	f.register = newRegisterContract(provider, conf.RegistryContractAddress)
+
+	protocol, err := ConfigProtocol(conf)
+	if err != nil {
+		return "tokens", "", err
+	}
+
+	f.protocol = protocol
+
+	if protocol == FTSOv2 {
+		if conf.Scaling == nil {
+			return "tokens", "", fmt.Errorf("no ftsov2 feeds order")
+		}
+
+		if f.feeds, err = ftsov2.ParseFeeds(conf.Scaling.Feeds); err != nil {
+			return "tokens", "", fmt.Errorf("parse ftsov2 feeds: %w", err)
+		}
+	}
+
+	set, err := f.newContractSet()
+	if err != nil {
+		return "tokens", "", fmt.Errorf("get contracts: %w", err)
+	}
+
+	if err := f.fillTokenIDs(set); err != nil {
+		return "tokens", "", fmt.Errorf("get supported tokens: %w", err)
+	}
+
+	if protocol == FTSOv2 {
+		return checkFeeds(f.feeds, tokens)
+	}
+
+	missing := []string{}
+	for _, t := range tokens {
+		token := contracts.GetTokenIDFromName(t)
+		if token == contracts.UnknownToken || f.tokens.Index(token).Sign() < 0 {
+			missing = append(missing, t)
+		}
+	}
+
+	if len(missing) > 0 {
+		return "tokens", "", fmt.Errorf("%s not supported by the FtsoRegistry", strings.Join(missing, ", "))
+	}
+
+	return "tokens", strings.Join(tokens, ", "), nil
+}
+
+// checkFeeds is used to check the tokens are submitted for the FTSOv2 feeds
+func checkFeeds(feeds []*ftsov2.Feed, tokens []string) (string, string, error) {
+	submitted := make(map[contracts.TokenID]string, len(feeds))
+	for _, f := range feeds {
+		if f.Token != contracts.UnknownToken {
+			submitted[f.Token] = f.Name
+		}
+	}
+
+	missing := []string{}
+	names := []string{}
+	for _, t := range tokens {
+		name, ok := submitted[contracts.GetTokenIDFromName(t)]
+		if !ok {
+			missing = append(missing, t)
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	if len(missing) > 0 {
+		return "tokens", "", fmt.Errorf("%s not in the ftsov2 feeds", strings.Join(missing, ", "))
+	}
+
+	return "tokens", strings.Join(names, ", "), nil
+}
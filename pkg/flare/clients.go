@@ -0,0 +1,77 @@
+package flare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"oracle-flare/config"
+	"oracle-flare/pkg/logger"
+	"oracle-flare/pkg/tracing"
+)
+
+// Clients are the rpc provider connections. They can be shared by several flare instances, e.g. of the different
+// signers of one chain, and are closed by the owner
+type Clients struct {
+	provider *ethclient.Client
+	// wsProvider is used for subscriptions. It is nil if no ws rpc url is given
+	wsProvider *ethclient.Client
+}
+
+// NewClients is used to dial the rpc providers of the flare config. The ws rpc provider is optional and used to
+// subscribe to the new heads and smart-contract events, polling is used without it
+func NewClients(conf *config.Flare) *Clients {
+	fields := logger.Fields{logger.FieldChainID: conf.ChainID}
+
+	if conf.RpcURL == "" {
+		logFatal("no rpc provider url found in the config", "Clients", fields)
+	}
+
+	rpc, err := dial(conf.RpcURL)
+	if err != nil {
+		logFatal(fmt.Sprintf("err dial provider %s: %s", conf.RpcURL, err.Error()), "Clients", fields)
+	}
+
+	c := &Clients{provider: rpc}
+
+	switch {
+	case conf.WSRpcURL != "":
+		ws, err := dial(conf.WSRpcURL)
+		if err != nil {
+			logWarn(fmt.Sprintf("err dial ws provider %s, polling is used: %s", conf.WSRpcURL, err.Error()), "Clients", fields)
+			break
+		}
+
+		c.wsProvider = ws
+	case strings.HasPrefix(conf.RpcURL, "ws"):
+		c.wsProvider = c.provider
+	}
+
+	return c
+}
+
+// dial is used to dial the rpc provider. Spans of the http rpc provider requests are recorded by the tracing transport
+func dial(url string) (*ethclient.Client, error) {
+	c, err := rpc.DialOptions(context.Background(), url, rpc.WithHTTPClient(&http.Client{Transport: tracing.RPCTransport(nil)}))
+	if err != nil {
+		return nil, err
+	}
+
+	return ethclient.NewClient(c), nil
+}
+
+// Close is used to close the rpc provider connections
+func (c *Clients) Close() {
+	logInfo("close rpc provider connection...", "Close")
+	if c.wsProvider != nil && c.wsProvider != c.provider {
+		c.wsProvider.Close()
+	}
+
+	if c.provider != nil {
+		c.provider.Close()
+	}
+}
@@ -0,0 +1,150 @@
+package flare
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"oracle-flare/pkg/flare/contracts"
+	"oracle-flare/pkg/flare/contracts/ftsov2"
+)
+
+// contractSet is a set of the flare smart-contracts resolved from the registry. It is replaced as a whole on each
+// reward epoch change
+type contractSet struct {
+	// addresses are all registered contract addresses mapped by the contract names
+	addresses map[string]common.Address
+
+	whitLister     contracts.IVoterWhiteLister
+	priceSubmitter contracts.IPriceSubmitter
+	ftsoManager    contracts.IFTSOManager
+	ftsoRegistry   contracts.IFTSORegistry
+	// fastUpdater is the FTSOv2 FastUpdater. It is nil if the fast updates are not enabled
+	fastUpdater contracts.IFastUpdater
+
+	// watchSubmitter is a PriceSubmitter bound to the ws provider if it is given. It is used for the events subscription
+	watchSubmitter contracts.IPriceSubmitter
+}
+
+// newContractSet is used to resolve all needed smart-contract addresses from the registry and create new contractSet
+// with the chain adapter constructors
+func (f *flare) newContractSet() (*contractSet, error) {
+	addresses, err := f.register.getAllContracts()
+	if err != nil {
+		return nil, fmt.Errorf("get all contracts: %w", err)
+	}
+
+	address := func(name string) (common.Address, error) {
+		a, ok := addresses[name]
+		if !ok {
+			return common.Address{}, fmt.Errorf("no %s contract in the registry", name)
+		}
+
+		return a, nil
+	}
+
+	if f.protocol == FTSOv2 {
+		return f.newScalingContractSet(addresses, address)
+	}
+
+	// For different chains different smart contracts (addresses and ABIs) are used.
+	// Each smart contract implements the contracts.IContracts interfaces
+
+	chainContracts, err := f.chain.NewContracts(f.provider, address, f.transactOpts, f.tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	set := &contractSet{
+		addresses:      addresses,
+		priceSubmitter: chainContracts.PriceSubmitter,
+		ftsoManager:    chainContracts.FTSOManager,
+		ftsoRegistry:   chainContracts.FTSORegistry,
+		whitLister:     chainContracts.VoterWhiteLister,
+		watchSubmitter: chainContracts.PriceSubmitter,
+	}
+
+	if f.wsProvider != nil {
+		wsContracts, err := f.chain.NewContracts(f.wsProvider, address, f.transactOpts, f.tokens)
+		if err != nil {
+			return nil, err
+		}
+
+		set.watchSubmitter = wsContracts.PriceSubmitter
+	}
+
+	return set, nil
+}
+
+// newScalingContractSet is used to create new contractSet of the FTSOv2 Scaling protocol. FtsoRegistry,
+// VoterWhitelister and WNat contracts are not used in it
+func (f *flare) newScalingContractSet(addresses map[string]common.Address, address func(string) (common.Address, error)) (*contractSet, error) {
+	submissionAddress, err := address("Submission")
+	if err != nil {
+		return nil, err
+	}
+
+	managerAddress, err := address("FlareSystemsManager")
+	if err != nil {
+		return nil, err
+	}
+
+	relayAddress, err := address("Relay")
+	if err != nil {
+		return nil, err
+	}
+
+	signing := &ftsov2.Signing{Key: f.signingPolicyKey, Relay: relayAddress}
+	if f.conf.Scaling != nil {
+		signing.ResultURL = f.conf.Scaling.ResultURL
+	}
+
+	set := &contractSet{
+		addresses: addresses,
+	}
+
+	// the watchers and pending confirmations of the current submitter are taken over by the new one
+	var prev contracts.IPriceSubmitter
+	if current := f.set.Load(); current != nil {
+		prev = current.priceSubmitter
+	}
+
+	if set.priceSubmitter, err = ftsov2.NewSubmission(f.provider, submissionAddress, f.transactOpts, f.feeds, signing, prev); err != nil {
+		return nil, err
+	}
+
+	if set.ftsoManager, err = ftsov2.NewSystemsManager(f.provider, managerAddress); err != nil {
+		return nil, err
+	}
+	// the Submission contract has no events, submissions are confirmed by the submitter itself
+	set.watchSubmitter = set.priceSubmitter
+
+	if f.sortitionKey != nil {
+		fastUpdaterAddress, err := address("FastUpdater")
+		if err != nil {
+			return nil, err
+		}
+
+		set.fastUpdater, err = ftsov2.NewFastUpdater(
+			f.provider, fastUpdaterAddress, managerAddress, f.transactOpts, f.signingPolicyKey, f.sortitionKey, f.conf.FastUpdates.Feeds,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return set, nil
+}
+
+// changed is used to get names of the contracts with different addresses in the given set
+func (s *contractSet) changed(other *contractSet) []string {
+	names := []string{}
+
+	for n, a := range other.addresses {
+		if s.addresses[n] != a {
+			names = append(names, n)
+		}
+	}
+
+	return names
+}
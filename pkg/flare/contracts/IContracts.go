@@ -1,29 +1,41 @@
 package contracts
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
 )
 
 // IPriceSubmitter is an interface for the PriceSubmitter smart-contract
 type IPriceSubmitter interface {
-	// CommitPrices is used to commit prices on-chain
-	CommitPrices(epochID *big.Int, indices []TokenID, prices []*big.Int, random *big.Int) error
-	// RevealPrices is used to reveal previously committed prices on-chain
-	RevealPrices(epochID *big.Int, indices []TokenID, prices []*big.Int, random *big.Int) error
+	// CommitPrices is used to commit prices on-chain. The ctx is the epoch trace context
+	CommitPrices(ctx context.Context, epochID *big.Int, indices []TokenID, prices []*big.Int, random *big.Int) error
+	// RevealPrices is used to reveal previously committed prices on-chain. The ctx is the epoch trace context
+	RevealPrices(ctx context.Context, epochID *big.Int, indices []TokenID, prices []*big.Int, random *big.Int) error
+	// FilterSubmissions is used to get submit and reveal events of given address in the given blocks range
+	FilterSubmissions(address common.Address, from uint64, to uint64) ([]*SubmissionEvent, error)
+	// WatchSubmissions is used to subscribe to submit and reveal events of given address. Needs ws rpc provider
+	WatchSubmissions(address common.Address, sink chan<- *SubmissionEvent) (event.Subscription, error)
 }
 
 // IFTSOManager is an interface for the FtsoManager smart-contract
 type IFTSOManager interface {
 	// GetCurrentPriceEpochData is used to get current epoch data
 	GetCurrentPriceEpochData() (*PriceEpochData, error)
+	// GetCurrentRewardEpoch is used to get current reward epoch id
+	GetCurrentRewardEpoch() (*big.Int, error)
+	// GetRewardEpochVotePowerBlock is used to get the vote power block for given reward epoch
+	GetRewardEpochVotePowerBlock(rewardEpoch *big.Int) (*big.Int, error)
 }
 
 // IFTSORegistry is an interface for the FtsoRegistry smart-contract
 type IFTSORegistry interface {
 	// GetSupportedIndicesAndSymbols is used to get supported indices and symbols
 	GetSupportedIndicesAndSymbols() (*IndicesAndSymbols, error)
+	// GetCurrentPriceWithDecimals is used to get the last finalized price for given token ID
+	GetCurrentPriceWithDecimals(index TokenID) (*CurrentPrice, error)
 }
 
 // IVoterWhiteLister is an interface for VoterWhiteLister smart-contract
@@ -32,4 +44,22 @@ type IVoterWhiteLister interface {
 	RequestWhitelistingVoter(address common.Address, index TokenID) error
 	// GetFtsoWhitelistedPriceProviders is used to get all data-providers for given token ID
 	GetFtsoWhitelistedPriceProviders(index TokenID) ([]common.Address, error)
+	// MaxVotersForFtso is used to get max number of whitelisted data-providers for given token ID
+	MaxVotersForFtso(index TokenID) (*big.Int, error)
+	// ChilledUntilRewardEpoch is used to get the reward epoch until which given address is chilled
+	ChilledUntilRewardEpoch(address common.Address) (*big.Int, error)
+	// GetVotePowerWeights is used to get the vote power weights of the addresses for given token ID, calculated the
+	// same way the whitelister compares the data-providers. The weights are relative to the given addresses only
+	GetVotePowerWeights(index TokenID, addresses []common.Address) ([]*big.Int, error)
+}
+
+// IFastUpdater is an interface for the FTSOv2 FastUpdater smart-contract
+type IFastUpdater interface {
+	// GetCurrentFeeds is used to get the current values of the fast updates feeds
+	GetCurrentFeeds() ([]*FastUpdateFeed, error)
+	// GetSortitionCredential is used to get the signer sortition credential for given block. Returns nil if the signer
+	// is not selected in the block
+	GetSortitionCredential(block *big.Int) (*SortitionCredential, error)
+	// SubmitUpdates is used to sign and submit the feed deltas
+	SubmitUpdates(update *FastUpdate) error
 }
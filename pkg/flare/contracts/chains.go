@@ -0,0 +1,88 @@
+package contracts
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// ChainContracts is a set of the FTSOv1 smart-contracts of a chain. VoterWhiteLister is nil if it is not supported by
+// the chain
+type ChainContracts struct {
+	PriceSubmitter   IPriceSubmitter
+	FTSOManager      IFTSOManager
+	FTSORegistry     IFTSORegistry
+	VoterWhiteLister IVoterWhiteLister
+}
+
+// ContractsConstructor is used to create the chain smart-contracts. Address is used to get the registered
+// smart-contract address by its name, tokens are used to get the token indices of the chain
+type ContractsConstructor func(
+	provider *ethclient.Client, address func(name string) (common.Address, error), signer Transactor, tokens *TokenTable,
+) (*ChainContracts, error)
+
+// ChainAdapter is a flare chain adapter. Chain packages register their adapters with RegisterChain in init, so a new
+// network is added with a new package imported by the flare package
+type ChainAdapter struct {
+	// ID is the chain ID
+	ID int
+	// Name is the chain name used in the logs, e.g. "FlareChain"
+	Name string
+	// Protocol is the default price submission protocol of the chain, e.g. "ftsov1"
+	Protocol string
+	// SymbolPrefix is prepended to the token names to get the FtsoRegistry symbols, e.g. "test" on the test-nets
+	SymbolPrefix string
+	// NativeSymbol is the FtsoRegistry symbol of the FLR token. The prefixed name is used if it is empty
+	NativeSymbol string
+	// NewContracts is used to create the FTSOv1 smart-contracts of the chain
+	NewContracts ContractsConstructor
+}
+
+// String is used to get ChainAdapter string value
+func (c *ChainAdapter) String() string {
+	return c.Name
+}
+
+var (
+	chainsMu sync.RWMutex
+	chains   = map[int]*ChainAdapter{}
+)
+
+// RegisterChain is used to register the chain adapter. It panics if the chain ID is already registered
+func RegisterChain(c *ChainAdapter) {
+	chainsMu.Lock()
+	defer chainsMu.Unlock()
+
+	if _, ok := chains[c.ID]; ok {
+		panic(fmt.Sprintf("chain id %v is already registered", c.ID))
+	}
+
+	chains[c.ID] = c
+}
+
+// GetChain is used to get the registered chain adapter by the chain ID
+func GetChain(id int) (*ChainAdapter, bool) {
+	chainsMu.RLock()
+	defer chainsMu.RUnlock()
+
+	c, ok := chains[id]
+
+	return c, ok
+}
+
+// ChainIDs is used to get the sorted IDs of all registered chains
+func ChainIDs() []int {
+	chainsMu.RLock()
+	defer chainsMu.RUnlock()
+
+	ids := make([]int, 0, len(chains))
+	for id := range chains {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	return ids
+}
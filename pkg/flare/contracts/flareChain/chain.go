@@ -0,0 +1,67 @@
+package flareChain
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"oracle-flare/pkg/flare/contracts"
+)
+
+func init() {
+	contracts.RegisterChain(&contracts.ChainAdapter{
+		ID:           14,
+		Name:         "FlareChain",
+		Protocol:     "ftsov1",
+		NativeSymbol: "FLR",
+		NewContracts: NewContracts,
+	})
+
+	// Coston2 is the Flare test-net with the same ABI
+	contracts.RegisterChain(&contracts.ChainAdapter{
+		ID:           114,
+		Name:         "Coston2Chain",
+		Protocol:     "ftsov1",
+		SymbolPrefix: "test",
+		NativeSymbol: "C2FLR",
+		NewContracts: NewContracts,
+	})
+}
+
+// NewContracts is used to create the Flare main-net smart-contracts. It can be used by the other chains with the same
+// ABI for methods that are used in this service
+func NewContracts(
+	provider *ethclient.Client, address func(name string) (common.Address, error), signer contracts.Transactor,
+	tokens *contracts.TokenTable,
+) (*contracts.ChainContracts, error) {
+	submitterAddress, err := address("PriceSubmitter")
+	if err != nil {
+		return nil, err
+	}
+
+	managerAddress, err := address("FtsoManager")
+	if err != nil {
+		return nil, err
+	}
+
+	registryAddress, err := address("FtsoRegistry")
+	if err != nil {
+		return nil, err
+	}
+
+	voterAddress, err := address("VoterWhitelister")
+	if err != nil {
+		return nil, err
+	}
+
+	voterWhiteLister, err := NewVoterWhiteLister(provider, voterAddress, registryAddress, signer, tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	return &contracts.ChainContracts{
+		PriceSubmitter:   NewPriceSubmitter(provider, submitterAddress, signer, tokens),
+		FTSOManager:      NewFTSOManager(provider, managerAddress),
+		FTSORegistry:     NewFTSORegistry(provider, registryAddress, tokens),
+		VoterWhiteLister: voterWhiteLister,
+	}, nil
+}
@@ -1,9 +1,6 @@
 package flareChain
 
 import (
-	"math/big"
-
-	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
@@ -11,22 +8,22 @@ import (
 	flare_abi "oracle-flare/abis/flare"
 	"oracle-flare/pkg/flare/contracts"
 	"oracle-flare/pkg/logger"
-	"oracle-flare/utils/contractUtils"
 )
 
 // ftsoRegistry is a FtsoRegistry flare-net smart-contract struct, implementing contracts.IFTSORegistry interface
 type ftsoRegistry struct {
 	address  common.Address
-	abi      *abi.ABI
-	contract *bind.BoundContract
+	contract *flare_abi.FtsoRegistry
 	provider *ethclient.Client
+	tokens   *contracts.TokenTable
 }
 
 // NewFTSORegistry is used to get new ftsoRegistry instance
-func NewFTSORegistry(provider *ethclient.Client, address common.Address) contracts.IFTSORegistry {
+func NewFTSORegistry(provider *ethclient.Client, address common.Address, tokens *contracts.TokenTable) contracts.IFTSORegistry {
 	c := &ftsoRegistry{
 		provider: provider,
 		address:  address,
+		tokens:   tokens,
 	}
 
 	c.init()
@@ -36,27 +33,31 @@ func NewFTSORegistry(provider *ethclient.Client, address common.Address) contrac
 
 // init is used to create new smart-contract instance
 func (c *ftsoRegistry) init() {
-	abiI, contract, err := contractUtils.GetContract(flare_abi.IFtsoRegistry, c.address, c.provider, c.provider)
+	contract, err := flare_abi.NewFtsoRegistry(c.address, c.provider)
 	if err != nil {
 		logger.Log().WithField("layer", "FTSO-Init").Fatalln("err get contract:", err.Error())
 	}
 
-	c.abi = abiI
 	c.contract = contract
 }
 
 // GetSupportedIndicesAndSymbols is used to get supported indices and symbols
 func (c *ftsoRegistry) GetSupportedIndicesAndSymbols() (*contracts.IndicesAndSymbols, error) {
-	out := []interface{}{}
-
-	if err := c.contract.Call(&bind.CallOpts{}, &out, "getSupportedIndicesAndSymbols"); err != nil {
+	out, err := c.contract.GetSupportedIndicesAndSymbols(&bind.CallOpts{})
+	if err != nil {
 		return nil, err
 	}
 
-	p := &contracts.IndicesAndSymbols{}
+	return &contracts.IndicesAndSymbols{Indices: out.SupportedIndices, Symbols: out.SupportedSymbols}, nil
+}
 
-	p.Indices = *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
-	p.Symbols = *abi.ConvertType(out[1], new([]string)).(*[]string)
+// GetCurrentPriceWithDecimals is used to get the last finalized price for given token ID
+func (c *ftsoRegistry) GetCurrentPriceWithDecimals(index contracts.TokenID) (*contracts.CurrentPrice, error) {
+	// the overloaded method with the asset index argument
+	out, err := c.contract.GetCurrentPriceWithDecimals(&bind.CallOpts{}, c.tokens.Index(index))
+	if err != nil {
+		return nil, err
+	}
 
-	return p, nil
+	return &contracts.CurrentPrice{Price: out.Price, Timestamp: out.Timestamp, Decimals: out.AssetPriceUsdDecimals}, nil
 }
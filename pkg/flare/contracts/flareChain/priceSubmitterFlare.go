@@ -1,36 +1,55 @@
 package flareChain
 
 import (
+	"context"
+	"fmt"
 	"math/big"
 	"sort"
+	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/event"
+	"go.opentelemetry.io/otel/attribute"
 
 	flare_abi "oracle-flare/abis/flare"
+	"oracle-flare/pkg/alerts"
 	"oracle-flare/pkg/flare/contracts"
 	"oracle-flare/pkg/logger"
+	"oracle-flare/pkg/tracing"
 	"oracle-flare/utils/abiCoder"
-	"oracle-flare/utils/contractUtils"
 )
 
+// receiptTimeout is a max time to wait for the submission tx receipt
+const receiptTimeout = time.Minute * 2
+
+// submissionMethods are the PriceSubmitter method names of the submission types used in the span names
+var submissionMethods = map[contracts.SubmissionEventType]string{
+	contracts.HashSubmitted:  "submitHash",
+	contracts.PricesRevealed: "revealPrices",
+}
+
 // priceSubmitter is a PriceSubmitter flare-net smart-contract struct, implementing contracts.IPriceSubmitter interface
 type priceSubmitter struct {
 	address  common.Address
-	signer   *bind.TransactOpts
+	signer   contracts.Transactor
+	tokens   *contracts.TokenTable
 	abi      *abi.ABI
-	contract *bind.BoundContract
+	contract *flare_abi.PriceSubmitter
 	provider *ethclient.Client
 }
 
 // NewPriceSubmitter is used to get new priceSubmitter instance
-func NewPriceSubmitter(provider *ethclient.Client, address common.Address, signer *bind.TransactOpts) contracts.IPriceSubmitter {
+func NewPriceSubmitter(provider *ethclient.Client, address common.Address, signer contracts.Transactor, tokens *contracts.TokenTable) contracts.IPriceSubmitter {
 	c := &priceSubmitter{
 		provider: provider,
 		address:  address,
 		signer:   signer,
+		tokens:   tokens,
 	}
 
 	c.init()
@@ -40,7 +59,12 @@ func NewPriceSubmitter(provider *ethclient.Client, address common.Address, signe
 
 // init is used to create new smart-contract instance
 func (c *priceSubmitter) init() {
-	abiI, contract, err := contractUtils.GetContract(flare_abi.IPriceSubmitter, c.address, c.provider, c.provider)
+	abiI, err := flare_abi.PriceSubmitterMetaData.GetAbi()
+	if err != nil {
+		logger.Log().WithField("layer", "PriceSubmitter-Init").Fatalln("err get abi:", err.Error())
+	}
+
+	contract, err := flare_abi.NewPriceSubmitter(c.address, c.provider)
 	if err != nil {
 		logger.Log().WithField("layer", "PriceSubmitter-Init").Fatalln("err get contract:", err.Error())
 	}
@@ -50,16 +74,16 @@ func (c *priceSubmitter) init() {
 }
 
 // CommitPrices is used to hash and commit given data
-func (c *priceSubmitter) CommitPrices(epochID *big.Int, indices []contracts.TokenID, prices []*big.Int, random *big.Int) error {
+func (c *priceSubmitter) CommitPrices(ctx context.Context, epochID *big.Int, indices []contracts.TokenID, prices []*big.Int, random *big.Int) error {
 	coder, err := abiCoder.NewCoder([]string{"uint256[]", "uint256[]", "uint256", "address"})
 	if err != nil {
-		logger.Log().WithField("layer", "PriceSubmitter-CommitPrices").Errorln("err create coder:", err.Error())
+		logger.WithLayer("PriceSubmitter-CommitPrices", logger.Fields{logger.FieldEpochID: epochID}).Errorln("err create coder:", err.Error())
 		return err
 	}
 
 	indicesBig := []*big.Int{}
 	for _, i := range indices {
-		indicesBig = append(indicesBig, i.Index())
+		indicesBig = append(indicesBig, c.tokens.Index(i))
 	}
 
 	sortStruct := SubmitterSort{
@@ -69,28 +93,41 @@ func (c *priceSubmitter) CommitPrices(epochID *big.Int, indices []contracts.Toke
 
 	sort.Sort(sortStruct)
 
-	hash, err := coder.KeccakHash(sortStruct.Indices, sortStruct.Prices, random, c.signer.From)
+	signer := c.signer()
+
+	_, hashSpan := tracing.Start(ctx, "abiCoder.KeccakHash")
+	hash, err := coder.KeccakHash(sortStruct.Indices, sortStruct.Prices, random, signer.From)
+	tracing.End(hashSpan, err)
 	if err != nil {
-		logger.Log().WithField("layer", "PriceSubmitter-CommitPrices").Errorln("err get hash:", err.Error())
+		logger.WithLayer("PriceSubmitter-CommitPrices", logger.Fields{logger.FieldEpochID: epochID}).Errorln("err get hash:", err.Error())
 		return err
 	}
 
-	tx, err := c.contract.Transact(c.signer, "submitHash", epochID, hash)
+	sendCtx, span := tracing.Start(ctx, "submitHash send")
+	signer.Context = sendCtx
+
+	tx, err := c.contract.SubmitHash(signer, epochID, hash)
 	if err != nil {
-		logger.Log().WithField("layer", "PriceSubmitter-CommitPrices").Errorln("err tx:", err.Error())
+		tracing.End(span, err)
+		logger.WithLayer("PriceSubmitter-CommitPrices", logger.Fields{logger.FieldEpochID: epochID}).Errorln("err tx:", err.Error())
 		return err
 	}
 
-	logger.Log().WithField("layer", "PriceSubmitter-CommitPrices").Infof("submitHash epochID: %v tx hash: %v time: %v", epochID, tx.Hash(), tx.Time())
+	span.SetAttributes(tracing.Tx(tx.Hash().Hex(), tx.Nonce())...)
+	tracing.End(span, nil)
+
+	logger.WithLayer("PriceSubmitter-CommitPrices", contracts.TxFields(tx, epochID)).Infof("submitHash epochID: %v tx hash: %v time: %v", epochID, tx.Hash(), tx.Time())
+
+	go c.checkReceipt(ctx, tx, contracts.HashSubmitted, epochID)
 
 	return nil
 }
 
 // RevealPrices is used to reveal given data
-func (c *priceSubmitter) RevealPrices(epochID *big.Int, indices []contracts.TokenID, prices []*big.Int, random *big.Int) error {
+func (c *priceSubmitter) RevealPrices(ctx context.Context, epochID *big.Int, indices []contracts.TokenID, prices []*big.Int, random *big.Int) error {
 	indicesBig := []*big.Int{}
 	for _, i := range indices {
-		indicesBig = append(indicesBig, i.Index())
+		indicesBig = append(indicesBig, c.tokens.Index(i))
 	}
 
 	sortStruct := SubmitterSort{
@@ -100,14 +137,153 @@ func (c *priceSubmitter) RevealPrices(epochID *big.Int, indices []contracts.Toke
 
 	sort.Sort(sortStruct)
 
-	c.signer.GasLimit = 2000000
-	tx, err := c.contract.Transact(c.signer, "revealPrices", epochID, sortStruct.Indices, sortStruct.Prices, random)
+	sendCtx, span := tracing.Start(ctx, "revealPrices send")
+	signer := c.signer()
+	signer.Context = sendCtx
+
+	tx, err := c.contract.RevealPrices(signer, epochID, sortStruct.Indices, sortStruct.Prices, random)
 	if err != nil {
-		logger.Log().WithField("layer", "PriceSubmitter-RevealPrices").Errorf("epochID: %v err tx: %s", epochID, err.Error())
+		tracing.End(span, err)
+		logger.WithLayer("PriceSubmitter-RevealPrices", logger.Fields{logger.FieldEpochID: epochID}).Errorf("epochID: %v err tx: %s", epochID, err.Error())
 		return err
 	}
 
-	logger.Log().WithField("layer", "PriceSubmitter-RevealPrices").Infof("revealPrices epochID: %v tx hash: %v time: %v", epochID, tx.Hash(), tx.Time())
+	span.SetAttributes(tracing.Tx(tx.Hash().Hex(), tx.Nonce())...)
+	tracing.End(span, nil)
+
+	logger.WithLayer("PriceSubmitter-RevealPrices", contracts.TxFields(tx, epochID)).Infof("revealPrices epochID: %v tx hash: %v time: %v", epochID, tx.Hash(), tx.Time())
+
+	go c.checkReceipt(ctx, tx, contracts.PricesRevealed, epochID)
 
 	return nil
 }
+
+// checkReceipt is used to wait for the submission receipt and alert if the tx is reverted. Successful submissions are
+// confirmed by the watched events. The receipt span is a child of the epoch trace ctx
+func (c *priceSubmitter) checkReceipt(ctx context.Context, tx *types.Transaction, t contracts.SubmissionEventType, epochID *big.Int) {
+	ctx, span := tracing.Start(ctx, fmt.Sprintf("%s receipt", submissionMethods[t]), tracing.Tx(tx.Hash().Hex(), tx.Nonce())...)
+	ctx, cancel := context.WithTimeout(ctx, receiptTimeout)
+	defer cancel()
+
+	receipt, err := bind.WaitMined(ctx, c.provider, tx)
+	if err != nil {
+		tracing.End(span, err)
+		logger.WithLayer("PriceSubmitter-Receipt", contracts.TxFields(tx, epochID)).Warnf("epochID: %v err wait %s tx %v: %s", epochID, t, tx.Hash(), err.Error())
+		return
+	}
+
+	span.SetAttributes(attribute.Int64("block_number", receipt.BlockNumber.Int64()), attribute.Int64("gas_used", int64(receipt.GasUsed)))
+
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		tracing.End(span, fmt.Errorf("tx reverted"))
+
+		msg := fmt.Sprintf("epochID: %v %s tx %v reverted", epochID, t, tx.Hash())
+		logger.WithLayer("PriceSubmitter-Receipt", contracts.TxFields(tx, epochID)).WithField("alert", alerts.TxReverted.String()).Error(msg)
+		alerts.Notify(alerts.TxReverted, "PriceSubmitter-Receipt", t.String(), msg)
+		return
+	}
+
+	tracing.End(span, nil)
+}
+
+// FilterSubmissions is used to get HashSubmitted and PricesRevealed events of given address in the given blocks range
+func (c *priceSubmitter) FilterSubmissions(address common.Address, from uint64, to uint64) ([]*contracts.SubmissionEvent, error) {
+	query := c.submissionsQuery(address)
+	query.FromBlock = new(big.Int).SetUint64(from)
+	query.ToBlock = new(big.Int).SetUint64(to)
+
+	logs, err := c.provider.FilterLogs(context.Background(), query)
+	if err != nil {
+		return nil, err
+	}
+
+	events := []*contracts.SubmissionEvent{}
+	for _, l := range logs {
+		e, err := c.parseSubmission(l)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, e)
+	}
+
+	return events, nil
+}
+
+// WatchSubmissions is used to subscribe to HashSubmitted and PricesRevealed events of given address
+func (c *priceSubmitter) WatchSubmissions(address common.Address, sink chan<- *contracts.SubmissionEvent) (event.Subscription, error) {
+	logs := make(chan types.Log)
+
+	sub, err := c.provider.SubscribeFilterLogs(context.Background(), c.submissionsQuery(address), logs)
+	if err != nil {
+		return nil, err
+	}
+
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case l := <-logs:
+				e, err := c.parseSubmission(l)
+				if err != nil {
+					return err
+				}
+
+				select {
+				case sink <- e:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// submissionsQuery is used to get filter query for the HashSubmitted and PricesRevealed events of given address.
+// Both events have the sender address as the first indexed argument
+func (c *priceSubmitter) submissionsQuery(address common.Address) ethereum.FilterQuery {
+	return ethereum.FilterQuery{
+		Addresses: []common.Address{c.address},
+		Topics: [][]common.Hash{
+			{c.abi.Events["HashSubmitted"].ID, c.abi.Events["PricesRevealed"].ID},
+			{common.BytesToHash(address.Bytes())},
+		},
+	}
+}
+
+// parseSubmission is used to parse HashSubmitted or PricesRevealed log
+func (c *priceSubmitter) parseSubmission(l types.Log) (*contracts.SubmissionEvent, error) {
+	e := &contracts.SubmissionEvent{
+		TxHash:      l.TxHash,
+		BlockNumber: l.BlockNumber,
+	}
+
+	switch l.Topics[0] {
+	case c.abi.Events["HashSubmitted"].ID:
+		submitted, err := c.contract.ParseHashSubmitted(l)
+		if err != nil {
+			return nil, err
+		}
+
+		e.Type = contracts.HashSubmitted
+		e.EpochID = submitted.EpochId
+	case c.abi.Events["PricesRevealed"].ID:
+		revealed, err := c.contract.ParsePricesRevealed(l)
+		if err != nil {
+			return nil, err
+		}
+
+		e.Type = contracts.PricesRevealed
+		e.EpochID = revealed.EpochId
+	default:
+		return nil, fmt.Errorf("unknown event: %s", l.Topics[0].Hex())
+	}
+
+	return e, nil
+}
@@ -1,7 +1,9 @@
 package flareChain
 
 import (
-	"github.com/ethereum/go-ethereum/accounts/abi"
+	"fmt"
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
@@ -9,44 +11,65 @@ import (
 	flare_abi "oracle-flare/abis/flare"
 	"oracle-flare/pkg/flare/contracts"
 	"oracle-flare/pkg/logger"
-	"oracle-flare/utils/contractUtils"
+)
+
+// tera and bips100 are the VoterWhitelister vote power weights precision constants
+var (
+	tera    = big.NewInt(1e12)
+	bips100 = big.NewInt(1e4)
 )
 
 // voterWhiteLister is a VoterWhiteLister flare-net smart-contract struct, implementing contracts.IVoterWhiteLister interface
 type voterWhiteLister struct {
 	address  common.Address
-	signer   *bind.TransactOpts
-	abi      *abi.ABI
-	contract *bind.BoundContract
-	provider *ethclient.Client
+	signer   contracts.Transactor
+	tokens   *contracts.TokenTable
+	contract *flare_abi.VoterWhitelister
+	// registry is used to get the token FTSO for the vote power weights
+	registryAddress common.Address
+	registry        *flare_abi.FtsoRegistry
+	provider        *ethclient.Client
 }
 
-// NewVoterWhiteLister is used to get new voterWhiteLister instance
-func NewVoterWhiteLister(provider *ethclient.Client, address common.Address, signer *bind.TransactOpts) contracts.IVoterWhiteLister {
+// NewVoterWhiteLister is used to get new voterWhiteLister instance. The FtsoRegistry address is used to get the token
+// FTSO vote weighting parameters
+func NewVoterWhiteLister(provider *ethclient.Client, address common.Address, registryAddress common.Address, signer contracts.Transactor, tokens *contracts.TokenTable) (contracts.IVoterWhiteLister, error) {
 	c := &voterWhiteLister{
-		provider: provider,
-		address:  address,
-		signer:   signer,
+		provider:        provider,
+		address:         address,
+		registryAddress: registryAddress,
+		signer:          signer,
+		tokens:          tokens,
 	}
 
-	c.init()
+	if err := c.init(); err != nil {
+		return nil, err
+	}
 
-	return c
+	return c, nil
 }
 
-// init is used to create new smart-contract instance
-func (c *voterWhiteLister) init() {
-	abiI, contract, err := contractUtils.GetContract(flare_abi.IVoterWhitelister, c.address, c.provider, c.provider)
+// init is used to create new smart-contract instances
+func (c *voterWhiteLister) init() error {
+	contract, err := flare_abi.NewVoterWhitelister(c.address, c.provider)
 	if err != nil {
-		logger.Log().WithField("layer", "VoterWhiteLister-Init").Fatalln("err get contract:", err.Error())
+		return fmt.Errorf("get voter whitelister contract: %w", err)
 	}
 
-	c.abi = abiI
 	c.contract = contract
+
+	registry, err := flare_abi.NewFtsoRegistry(c.registryAddress, c.provider)
+	if err != nil {
+		return fmt.Errorf("get ftso registry contract: %w", err)
+	}
+
+	c.registry = registry
+
+	return nil
 }
 
 func (c *voterWhiteLister) RequestWhitelistingVoter(address common.Address, index contracts.TokenID) error {
-	tx, err := c.contract.Transact(c.signer, "requestWhitelistingVoter", address, index.Index())
+	tx, err := c.contract.RequestWhitelistingVoter(c.signer(), address, c.tokens.Index(index))
 	if err != nil {
 		logger.Log().WithField("layer", "VoterWhiteLister-RequestWhitelistingVoter").Errorln("err tx:", err.Error())
 		return err
@@ -58,14 +81,163 @@ func (c *voterWhiteLister) RequestWhitelistingVoter(address common.Address, inde
 }
 
 func (c *voterWhiteLister) GetFtsoWhitelistedPriceProviders(index contracts.TokenID) ([]common.Address, error) {
-	out := []interface{}{}
-
-	if err := c.contract.Call(&bind.CallOpts{}, &out, "getFtsoWhitelistedPriceProviders", index.Index()); err != nil {
+	addresses, err := c.contract.GetFtsoWhitelistedPriceProviders(&bind.CallOpts{}, c.tokens.Index(index))
+	if err != nil {
 		logger.Log().WithField("layer", "VoterWhiteLister-RequestWhitelistingVoter").Errorln("err tx:", err.Error())
 		return nil, err
 	}
 
-	addresses := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
-
 	return addresses, nil
 }
+
+func (c *voterWhiteLister) MaxVotersForFtso(index contracts.TokenID) (*big.Int, error) {
+	res, err := c.contract.MaxVotersForFtso(&bind.CallOpts{}, c.tokens.Index(index))
+	if err != nil {
+		logger.Log().WithField("layer", "VoterWhiteLister-MaxVotersForFtso").Errorln("err call:", err.Error())
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *voterWhiteLister) ChilledUntilRewardEpoch(address common.Address) (*big.Int, error) {
+	res, err := c.contract.ChilledUntilRewardEpoch(&bind.CallOpts{}, address)
+	if err != nil {
+		logger.Log().WithField("layer", "VoterWhiteLister-ChilledUntilRewardEpoch").Errorln("err call:", err.Error())
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetVotePowerWeights is used to get the vote power weights like the VoterWhitelister: the WNat and the asset vote
+// powers at the FTSO vote power block are normalized by the FTSO totals and combined by the asset weight ratio
+func (c *voterWhiteLister) GetVotePowerWeights(index contracts.TokenID, addresses []common.Address) ([]*big.Int, error) {
+	ftsoAddress, err := c.registry.GetFtso(&bind.CallOpts{}, c.tokens.Index(index))
+	if err != nil {
+		logger.Log().WithField("layer", "VoterWhiteLister-GetVotePowerWeights").Errorln("err get ftso:", err.Error())
+		return nil, err
+	}
+
+	ftso, err := flare_abi.NewFtso(ftsoAddress, c.provider)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := ftso.GetVoteWeightingParameters(&bind.CallOpts{})
+	if err != nil {
+		logger.Log().WithField("layer", "VoterWhiteLister-GetVotePowerWeights").Errorln("err get vote weighting parameters:", err.Error())
+		return nil, err
+	}
+
+	wNatAddress, err := ftso.WNat(&bind.CallOpts{})
+	if err != nil {
+		return nil, err
+	}
+
+	natWeights, err := c.votePowers(wNatAddress, addresses, params.VotePowerBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.TotalVotePowerNat.Sign() > 0 {
+		for i := range natWeights {
+			natWeights[i] = mulDiv(natWeights[i], tera, params.TotalVotePowerNat)
+		}
+	}
+
+	assetWeights := make([]*big.Int, len(addresses))
+	for i := range assetWeights {
+		assetWeights[i] = big.NewInt(0)
+	}
+
+	if params.TotalVotePowerAsset.Sign() > 0 {
+		divisor := new(big.Int).Mul(params.TotalVotePowerAsset, big.NewInt(1e18))
+
+		for n, asset := range params.Assets {
+			if asset == (common.Address{}) {
+				continue
+			}
+
+			assetVP, err := c.votePowers(asset, addresses, params.VotePowerBlock)
+			if err != nil {
+				return nil, err
+			}
+
+			for i := range assetWeights {
+				assetWeights[i].Add(assetWeights[i], mulDiv(assetVP[i], params.AssetMultipliers[n], divisor))
+			}
+		}
+	}
+
+	return weightedSum(natWeights, assetWeights, params.AssetWeightRatio), nil
+}
+
+// votePowers is used to get the vote powers of the addresses at the block. WNat and the asset tokens share the vote
+// power token interface, so the WNat binding is used for all of them
+func (c *voterWhiteLister) votePowers(token common.Address, addresses []common.Address, block *big.Int) ([]*big.Int, error) {
+	contract, err := flare_abi.NewWNat(token, c.provider)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*big.Int, 0, len(addresses))
+	for _, a := range addresses {
+		vp, err := contract.VotePowerOfAt(&bind.CallOpts{}, a, block)
+		if err != nil {
+			logger.Log().WithField("layer", "VoterWhiteLister-GetVotePowerWeights").Errorln("err get vote power:", err.Error())
+			return nil, err
+		}
+
+		res = append(res, vp)
+	}
+
+	return res, nil
+}
+
+// weightedSum is used to combine the WNat and asset weights by the asset weight ratio in BIPS. A share is dropped if
+// its weights sum is 0
+func weightedSum(natWeights []*big.Int, assetWeights []*big.Int, assetWeightRatio *big.Int) []*big.Int {
+	natSum, assetSum := sum(natWeights), sum(assetWeights)
+
+	assetShare := big.NewInt(0)
+	if assetSum.Sign() > 0 {
+		assetShare = assetWeightRatio
+	}
+
+	natShare := big.NewInt(0)
+	if natSum.Sign() > 0 {
+		natShare = new(big.Int).Sub(bips100, assetShare)
+	}
+
+	weights := make([]*big.Int, len(natWeights))
+	for i := range natWeights {
+		weights[i] = big.NewInt(0)
+
+		if natShare.Sign() > 0 {
+			weights[i].Add(weights[i], mulDiv(natShare, new(big.Int).Mul(tera, natWeights[i]), new(big.Int).Mul(natSum, bips100)))
+		}
+
+		if assetShare.Sign() > 0 {
+			weights[i].Add(weights[i], mulDiv(assetShare, new(big.Int).Mul(tera, assetWeights[i]), new(big.Int).Mul(assetSum, bips100)))
+		}
+	}
+
+	return weights
+}
+
+// mulDiv is used to get x * y / z rounded down
+func mulDiv(x *big.Int, y *big.Int, z *big.Int) *big.Int {
+	res := new(big.Int).Mul(x, y)
+	return res.Div(res, z)
+}
+
+// sum is used to get the sum of the values
+func sum(values []*big.Int) *big.Int {
+	res := big.NewInt(0)
+	for _, v := range values {
+		res.Add(res, v)
+	}
+
+	return res
+}
@@ -0,0 +1,247 @@
+package ftsov2
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	ftsov2_abi "oracle-flare/abis/ftsov2"
+	"oracle-flare/pkg/flare/contracts"
+	"oracle-flare/pkg/logger"
+)
+
+// sortitionState is the signer sortition weight and seed of the reward epoch
+type sortitionState struct {
+	rewardEpoch *big.Int
+	seed        *big.Int
+	weight      *big.Int
+}
+
+// fastUpdater is a FastUpdater smart-contract struct, implementing contracts.IFastUpdater interface. The sortition
+// seed is taken from the FlareSystemsManager. The backend is bind.ContractBackend, so it can be used with the
+// simulated backend
+type fastUpdater struct {
+	address        common.Address
+	managerAddress common.Address
+	signer         contracts.Transactor
+	// key is the signing policy private key, sortitionKey is the fast updates sortition key
+	key          *ecdsa.PrivateKey
+	sortitionKey *SortitionKey
+	// feeds are the fast updates feed names in the FastUpdater indices order
+	feeds    []string
+	contract *ftsov2_abi.FastUpdater
+	manager  *ftsov2_abi.FlareSystemsManager
+	backend  bind.ContractBackend
+
+	// mu guards the state. It is changed on the reward epoch change only
+	mu    sync.Mutex
+	state *sortitionState
+}
+
+// NewFastUpdater is used to get new fastUpdater instance. Feeds are the feed names in the FastUpdater indices order
+func NewFastUpdater(
+	backend bind.ContractBackend, address common.Address, managerAddress common.Address, signer contracts.Transactor,
+	key *ecdsa.PrivateKey, sortitionKey *SortitionKey, feeds []string,
+) (contracts.IFastUpdater, error) {
+	names := make([]string, len(feeds))
+	for i, f := range feeds {
+		names[i] = strings.ToUpper(f)
+	}
+
+	c := &fastUpdater{
+		backend:        backend,
+		address:        address,
+		managerAddress: managerAddress,
+		signer:         signer,
+		key:            key,
+		sortitionKey:   sortitionKey,
+		feeds:          names,
+	}
+
+	if err := c.init(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// init is used to create new smart-contract instances
+func (c *fastUpdater) init() error {
+	contract, err := ftsov2_abi.NewFastUpdater(c.address, c.backend)
+	if err != nil {
+		return fmt.Errorf("get fast updater contract: %w", err)
+	}
+
+	c.contract = contract
+
+	manager, err := ftsov2_abi.NewFlareSystemsManager(c.managerAddress, c.backend)
+	if err != nil {
+		return fmt.Errorf("get flare systems manager contract: %w", err)
+	}
+
+	c.manager = manager
+
+	return nil
+}
+
+// GetCurrentFeeds is used to get the current feed values. The on-chain feed IDs are checked against the configured
+// feed names, so the deltas are never submitted for the wrong feeds
+func (c *fastUpdater) GetCurrentFeeds() ([]*contracts.FastUpdateFeed, error) {
+	indices := make([]*big.Int, len(c.feeds))
+	for i := range c.feeds {
+		indices[i] = big.NewInt(int64(i))
+	}
+
+	out, err := c.contract.FetchCurrentFeeds(&bind.CallOpts{}, indices)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, values, decimals := out.FeedIds, out.Feeds, out.Decimals
+
+	if len(ids) != len(c.feeds) || len(values) != len(c.feeds) || len(decimals) != len(c.feeds) {
+		return nil, fmt.Errorf("%v feeds received, %v expected", len(ids), len(c.feeds))
+	}
+
+	feeds := make([]*contracts.FastUpdateFeed, len(c.feeds))
+	for i, name := range c.feeds {
+		id, err := NewFeedID(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if FeedID(ids[i]) != id {
+			return nil, fmt.Errorf("feed %v is %v, %s expected", i, FeedID(ids[i]), name)
+		}
+
+		token := contracts.UnknownToken
+		if base, quote, ok := strings.Cut(name, "/"); ok && quote == "USD" {
+			token = contracts.GetTokenIDFromName(base)
+		}
+
+		feeds[i] = &contracts.FastUpdateFeed{Index: i, Name: name, Token: token, Value: values[i], Decimals: int(decimals[i])}
+	}
+
+	return feeds, nil
+}
+
+// GetSortitionCredential is used to get the signer sortition credential for given block by the block score cutoff and
+// the signer reward epoch sortition weight
+func (c *fastUpdater) GetSortitionCredential(block *big.Int) (*contracts.SortitionCredential, error) {
+	state, err := c.sortitionState()
+	if err != nil {
+		return nil, err
+	}
+
+	if state.weight.Sign() == 0 {
+		return nil, nil
+	}
+
+	cutoff, err := c.contract.BlockScoreCutoff(&bind.CallOpts{}, block)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.sortitionKey.credential(state.seed, block, state.weight, cutoff)
+}
+
+// sortitionState is used to get the signer sortition state of the current reward epoch. It is loaded again when the
+// reward epoch is changed
+func (c *fastUpdater) sortitionState() (*sortitionState, error) {
+	rewardEpoch, err := c.contract.CurrentRewardEpochId(&bind.CallOpts{})
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.state != nil && c.state.rewardEpoch.Cmp(rewardEpoch) == 0 {
+		return c.state, nil
+	}
+
+	seed, err := c.manager.GetSeed(&bind.CallOpts{}, rewardEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	weight, err := c.contract.CurrentSortitionWeight(&bind.CallOpts{}, crypto.PubkeyToAddress(c.key.PublicKey))
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Log().WithField("layer", "FastUpdater-SortitionState").Infof("reward epoch: %v sortition weight: %v", rewardEpoch, weight)
+
+	c.state = &sortitionState{rewardEpoch: rewardEpoch, seed: seed, weight: weight}
+
+	return c.state, nil
+}
+
+// SubmitUpdates is used to sign the update with the signing policy key and send it with submitUpdates
+func (c *fastUpdater) SubmitUpdates(update *contracts.FastUpdate) error {
+	cred := update.Credential
+	deltas := encodeDeltas(update.Deltas)
+
+	hash := crypto.Keccak256(
+		common.BigToHash(update.SortitionBlock).Bytes(), common.BigToHash(cred.Replicate).Bytes(),
+		common.BigToHash(cred.GammaX).Bytes(), common.BigToHash(cred.GammaY).Bytes(),
+		common.BigToHash(cred.C).Bytes(), common.BigToHash(cred.S).Bytes(), deltas,
+	)
+
+	sig, err := crypto.Sign(accounts.TextHash(hash), c.key)
+	if err != nil {
+		return err
+	}
+
+	arg := ftsov2_abi.IFastUpdaterFastUpdates{
+		SortitionBlock: update.SortitionBlock,
+		SortitionCredential: ftsov2_abi.SortitionCredential{
+			Replicate: cred.Replicate,
+			Gamma:     ftsov2_abi.G1Point{X: cred.GammaX, Y: cred.GammaY},
+			C:         cred.C,
+			S:         cred.S,
+		},
+		Deltas:    deltas,
+		Signature: ftsov2_abi.IFastUpdaterSignature{V: sig[64] + 27, R: [32]byte(sig[:32]), S: [32]byte(sig[32:64])},
+	}
+
+	tx, err := c.contract.SubmitUpdates(c.signer(), arg)
+	if err != nil {
+		logger.Log().WithField("layer", "FastUpdater-SubmitUpdates").Errorf("block: %v err tx: %s", update.SortitionBlock, err.Error())
+		return err
+	}
+
+	logger.WithLayer("FastUpdater-SubmitUpdates", contracts.TxFields(tx, nil)).Infof("block: %v deltas: %x tx hash: %v", update.SortitionBlock, deltas, tx.Hash())
+
+	return nil
+}
+
+// encodeDeltas is used to encode the deltas as 2 bits per feed, 4 feeds per byte from the high bits: 01 is an increase,
+// 11 is a decrease and 00 is no change. Trailing no change bytes are trimmed
+func encodeDeltas(deltas []int8) []byte {
+	res := make([]byte, (len(deltas)+3)/4)
+
+	for i, d := range deltas {
+		shift := 6 - 2*(i%4)
+
+		switch {
+		case d > 0:
+			res[i/4] |= 0b01 << shift
+		case d < 0:
+			res[i/4] |= 0b11 << shift
+		}
+	}
+
+	for len(res) > 0 && res[len(res)-1] == 0 {
+		res = res[:len(res)-1]
+	}
+
+	return res
+}
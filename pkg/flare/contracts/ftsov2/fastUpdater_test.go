@@ -0,0 +1,489 @@
+package ftsov2
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/asm"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+
+	ftsov2_abi "oracle-flare/abis/ftsov2"
+	"oracle-flare/pkg/flare/contracts"
+)
+
+// mock FastUpdater and FlareSystemsManager settings
+var (
+	mockAddress     = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	mockRewardEpoch = big.NewInt(7)
+	mockSeed        = big.NewInt(123456789)
+	mockWeight      = big.NewInt(4)
+	mockFeeds       = []string{"BTC/USD", "ETH/USD", "XRP/USD"}
+	mockValues      = []*big.Int{big.NewInt(6032145), big.NewInt(301218), big.NewInt(5123)}
+	mockDecimals    = []int8{2, 2, 4}
+)
+
+// mockSource is the mock FastUpdater and FlareSystemsManager contract assembly. submitUpdates checks the replicate is
+// below the sortition weight and the signature of the update is signed by the signing policy address like the
+// FastUpdater does, then logs the deltas. The sortition weight is returned for the signing policy address only. The
+// fetchCurrentFeeds result is copied from the data appended to the code
+const mockSource = `
+	PUSH 0
+	CALLDATALOAD
+	PUSH 0xe0
+	SHR
+	DUP1
+	PUSH 0x%[1]x ;; currentRewardEpochId
+	EQ
+	JUMPI @epoch
+	DUP1
+	PUSH 0x%[2]x ;; getSeed
+	EQ
+	JUMPI @seed
+	DUP1
+	PUSH 0x%[3]x ;; currentSortitionWeight
+	EQ
+	JUMPI @weight
+	DUP1
+	PUSH 0x%[4]x ;; blockScoreCutoff
+	EQ
+	JUMPI @cutoff
+	DUP1
+	PUSH 0x%[5]x ;; fetchCurrentFeeds
+	EQ
+	JUMPI @feeds
+	DUP1
+	PUSH 0x%[6]x ;; submitUpdates
+	EQ
+	JUMPI @submit
+	PUSH 0
+	DUP1
+	REVERT
+
+epoch:
+	PUSH 0x%[7]x
+	JUMP @return
+
+seed:
+	PUSH 0x%[8]x
+	JUMP @return
+
+weight:
+	PUSH 4
+	CALLDATALOAD
+	PUSH 0x%[9]x ;; signing policy address
+	EQ
+	PUSH 0x%[10]x
+	MUL
+	JUMP @return
+
+cutoff:
+	PUSH 0x%[11]x
+	JUMP @return
+
+return:
+	PUSH 0
+	MSTORE
+	PUSH 0x20
+	PUSH 0
+	RETURN
+
+feeds:
+	PUSH 0x%[12]x
+	PUSH @data
+	PUSH 1
+	ADD
+	PUSH 0
+	CODECOPY
+	PUSH 0x%[12]x
+	PUSH 0
+	RETURN
+
+submit:
+	;; the updates tuple start
+	PUSH 4
+	CALLDATALOAD
+	PUSH 4
+	ADD
+
+	;; replicate < weight
+	PUSH 0x%[10]x
+	DUP2
+	PUSH 0x20
+	ADD
+	CALLDATALOAD
+	LT
+	JUMPI @replicate
+	PUSH 0
+	DUP1
+	REVERT
+replicate:
+
+	;; sortitionBlock, credential and deltas are packed to the memory
+	PUSH 0xc0
+	DUP2
+	PUSH 0
+	CALLDATACOPY
+	DUP1
+	PUSH 0xc0
+	ADD
+	CALLDATALOAD
+	DUP2
+	ADD
+	DUP1
+	CALLDATALOAD
+	DUP1
+	DUP3
+	PUSH 0x20
+	ADD
+	PUSH 0xc0
+	CALLDATACOPY
+	DUP1
+	PUSH 0xc0
+	LOG0
+
+	;; keccak256 of the packed values with the eth signed message prefix
+	DUP1
+	PUSH 0xc0
+	ADD
+	PUSH 0
+	KECCAK256
+	PUSH 0x19457468657265756d205369676e6564204d6573736167653a0a333200000000
+	PUSH 0
+	MSTORE
+	PUSH 28
+	MSTORE
+	PUSH 60
+	PUSH 0
+	KECCAK256
+
+	;; ecrecover of the signature
+	PUSH 0x100
+	MSTORE
+	DUP3
+	PUSH 0xe0
+	ADD
+	CALLDATALOAD
+	PUSH 0x120
+	MSTORE
+	DUP3
+	PUSH 0x100
+	ADD
+	CALLDATALOAD
+	PUSH 0x140
+	MSTORE
+	DUP3
+	PUSH 0x120
+	ADD
+	CALLDATALOAD
+	PUSH 0x160
+	MSTORE
+	PUSH 0
+	PUSH 0x180
+	MSTORE
+	PUSH 0x20
+	PUSH 0x180
+	PUSH 0x80
+	PUSH 0x100
+	PUSH 1
+	GAS
+	STATICCALL
+	POP
+	PUSH 0x180
+	MLOAD
+	PUSH 0x%[9]x
+	EQ
+	JUMPI @signed
+	PUSH 0
+	DUP1
+	REVERT
+signed:
+	STOP
+
+data:
+`
+
+// newMockBackend is used to get the simulated backend with the mock contract deployed at the mockAddress and the
+// funded signer
+func newMockBackend(t *testing.T, signer common.Address, signingPolicy common.Address) *backends.SimulatedBackend {
+	t.Helper()
+
+	fuABI, err := abi.JSON(strings.NewReader(ftsov2_abi.FastUpdaterMetaData.ABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	managerABI, err := abi.JSON(strings.NewReader(ftsov2_abi.FlareSystemsManagerMetaData.ABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ids := make([][21]byte, len(mockFeeds))
+	for i, name := range mockFeeds {
+		id, err := NewFeedID(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids[i] = id
+	}
+
+	feeds, err := fuABI.Methods["fetchCurrentFeeds"].Outputs.Pack(ids, mockValues, mockDecimals, uint64(1714564800))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	selector := func(m abi.Method) *big.Int {
+		return new(big.Int).SetBytes(m.ID)
+	}
+
+	src := fmt.Sprintf(mockSource,
+		selector(fuABI.Methods["currentRewardEpochId"]), selector(managerABI.Methods["getSeed"]),
+		selector(fuABI.Methods["currentSortitionWeight"]), selector(fuABI.Methods["blockScoreCutoff"]),
+		selector(fuABI.Methods["fetchCurrentFeeds"]), selector(fuABI.Methods["submitUpdates"]),
+		mockRewardEpoch, mockSeed, signingPolicy.Big(), mockWeight, bn256.P, len(feeds),
+	)
+
+	compiler := asm.NewCompiler(false)
+	compiler.Feed(asm.Lex([]byte(src), false))
+
+	code, errs := compiler.Compile()
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+
+	bin, err := hex.DecodeString(code)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{
+		signer:      {Balance: new(big.Int).Lsh(big.NewInt(1), 100)},
+		mockAddress: {Code: append(bin, feeds...)},
+	}, 30_000_000)
+	t.Cleanup(func() { _ = backend.Close() })
+
+	return backend
+}
+
+// testKeys are the keys of the test entity
+type testKeys struct {
+	submit        *ecdsa.PrivateKey
+	signingPolicy *ecdsa.PrivateKey
+	sortition     *SortitionKey
+}
+
+func newTestKeys(t *testing.T) *testKeys {
+	t.Helper()
+
+	submit, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signingPolicy, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sortition, err := NewSortitionKey("0x2a9f6c0b4de1c3b5a7f1e2d3c4b5a69788796a5b4c3d2e1f0a1b2c3d4e5f6071")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &testKeys{submit: submit, signingPolicy: signingPolicy, sortition: sortition}
+}
+
+// newTestFastUpdater is used to get the fastUpdater of the mock contract signing with the key
+func newTestFastUpdater(t *testing.T, backend bind.ContractBackend, keys *testKeys, key *ecdsa.PrivateKey) contracts.IFastUpdater {
+	t.Helper()
+
+	opts, err := bind.NewKeyedTransactorWithChainID(keys.submit, big.NewInt(1337))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signer := func() *bind.TransactOpts {
+		o := *opts
+		return &o
+	}
+
+	fu, err := NewFastUpdater(backend, mockAddress, mockAddress, signer, key, keys.sortition, mockFeeds)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return fu
+}
+
+// verifyCredential is used to check the sortition credential VRF proof the way the FastUpdater verifies it:
+// c = keccak256(g, h, pk, gamma, s * g + c * pk, s * h + c * gamma)
+func verifyCredential(t *testing.T, key *SortitionKey, seed *big.Int, block *big.Int, cred *contracts.SortitionCredential) {
+	t.Helper()
+
+	h, err := hashToG1(seed, block, cred.Replicate)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf := make([]byte, 64)
+	cred.GammaX.FillBytes(buf[:32])
+	cred.GammaY.FillBytes(buf[32:])
+
+	gamma := new(bn256.G1)
+	if _, err := gamma.Unmarshal(buf); err != nil {
+		t.Fatalf("gamma is not on the curve: %s", err.Error())
+	}
+
+	g := new(bn256.G1).ScalarBaseMult(big.NewInt(1))
+	u := new(bn256.G1).Add(new(bn256.G1).ScalarBaseMult(cred.S), new(bn256.G1).ScalarMult(key.pk, cred.C))
+	v := new(bn256.G1).Add(new(bn256.G1).ScalarMult(h, cred.S), new(bn256.G1).ScalarMult(gamma, cred.C))
+
+	c := new(big.Int).SetBytes(crypto.Keccak256(g.Marshal(), h.Marshal(), key.pk.Marshal(), gamma.Marshal(), u.Marshal(), v.Marshal()))
+	c.Mod(c, bn256.Order)
+
+	if c.Cmp(cred.C) != 0 {
+		t.Fatal("invalid sortition credential proof")
+	}
+}
+
+// lastReceipt is used to get the receipt of the only transaction of the latest block
+func lastReceipt(t *testing.T, backend *backends.SimulatedBackend) *types.Receipt {
+	t.Helper()
+
+	block, err := backend.BlockByNumber(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(block.Transactions()) != 1 {
+		t.Fatalf("%v transactions in the block, 1 expected", len(block.Transactions()))
+	}
+
+	receipt, err := backend.TransactionReceipt(context.Background(), block.Transactions()[0].Hash())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return receipt
+}
+
+func TestFastUpdaterSubmitUpdates(t *testing.T) {
+	keys := newTestKeys(t)
+	backend := newMockBackend(t, crypto.PubkeyToAddress(keys.submit.PublicKey), crypto.PubkeyToAddress(keys.signingPolicy.PublicKey))
+	fu := newTestFastUpdater(t, backend, keys, keys.signingPolicy)
+
+	block := new(big.Int).SetUint64(backend.Blockchain().CurrentBlock().Number.Uint64())
+
+	cred, err := fu.GetSortitionCredential(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cred == nil {
+		t.Fatal("not selected with the signing policy address sortition weight")
+	}
+
+	if cred.Replicate.Cmp(mockWeight) >= 0 {
+		t.Errorf("replicate %v is not below the weight %v", cred.Replicate, mockWeight)
+	}
+
+	verifyCredential(t, keys.sortition, mockSeed, block, cred)
+
+	deltas := []int8{1, -1, 0, 0, 1, 0, 0}
+	if err := fu.SubmitUpdates(&contracts.FastUpdate{SortitionBlock: block, Credential: cred, Deltas: deltas}); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	receipt := lastReceipt(t, backend)
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		t.Fatal("submitUpdates reverted")
+	}
+
+	// +, -, 0, 0 are 01 11 00 00, + is 01 and the trailing no change bits are trimmed
+	want := []byte{0x70, 0x40}
+	if len(receipt.Logs) != 1 || !bytes.Equal(receipt.Logs[0].Data, want) {
+		t.Errorf("submitted deltas: got %v, want %x", receipt.Logs, want)
+	}
+}
+
+func TestFastUpdaterSigningPolicyKey(t *testing.T) {
+	keys := newTestKeys(t)
+	backend := newMockBackend(t, crypto.PubkeyToAddress(keys.submit.PublicKey), crypto.PubkeyToAddress(keys.signingPolicy.PublicKey))
+
+	// the submit key has no sortition weight and its signature is rejected by the contract
+	fu := newTestFastUpdater(t, backend, keys, keys.submit)
+
+	block := new(big.Int).SetUint64(backend.Blockchain().CurrentBlock().Number.Uint64())
+
+	cred, err := fu.GetSortitionCredential(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cred != nil {
+		t.Fatal("selected with the submit address")
+	}
+
+	cred, err = keys.sortition.credential(mockSeed, block, mockWeight, bn256.P)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := fu.SubmitUpdates(&contracts.FastUpdate{SortitionBlock: block, Credential: cred, Deltas: []int8{1}}); err == nil {
+		t.Fatal("update signed with the submit key is not rejected")
+	}
+}
+
+func TestFastUpdaterGetCurrentFeeds(t *testing.T) {
+	keys := newTestKeys(t)
+	backend := newMockBackend(t, crypto.PubkeyToAddress(keys.submit.PublicKey), crypto.PubkeyToAddress(keys.signingPolicy.PublicKey))
+	fu := newTestFastUpdater(t, backend, keys, keys.signingPolicy)
+
+	feeds, err := fu.GetCurrentFeeds()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(feeds) != len(mockFeeds) {
+		t.Fatalf("got %v feeds, want %v", len(feeds), len(mockFeeds))
+	}
+
+	tokens := []contracts.TokenID{contracts.BTC, contracts.ETH, contracts.XRP}
+	for i, f := range feeds {
+		if f.Name != mockFeeds[i] || f.Token != tokens[i] || f.Value.Cmp(mockValues[i]) != 0 || f.Decimals != int(mockDecimals[i]) {
+			t.Errorf("feed %v: got %+v", i, f)
+		}
+	}
+}
+
+func TestEncodeDeltas(t *testing.T) {
+	tests := []struct {
+		deltas []int8
+		want   []byte
+	}{
+		{deltas: nil, want: []byte{}},
+		{deltas: []int8{0, 0, 0}, want: []byte{}},
+		{deltas: []int8{1, -1, 0, 1}, want: []byte{0x71}},
+		{deltas: []int8{0, 0, 0, 0, -1}, want: []byte{0x00, 0xc0}},
+		{deltas: []int8{-1, 0, 0, 0, 0, 0}, want: []byte{0xc0}},
+	}
+
+	for _, tt := range tests {
+		if got := encodeDeltas(tt.deltas); !bytes.Equal(got, tt.want) {
+			t.Errorf("%v: got %x, want %x", tt.deltas, got, tt.want)
+		}
+	}
+}
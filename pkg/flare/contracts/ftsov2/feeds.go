@@ -0,0 +1,130 @@
+package ftsov2
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"oracle-flare/pkg/flare/contracts"
+	"oracle-flare/pkg/logger"
+)
+
+const (
+	// cryptoCategory is a feed ID category of the crypto feeds
+	cryptoCategory = 0x01
+	// feedIDLength is a feed ID length in bytes: 1 byte category and 20 bytes name
+	feedIDLength = 21
+	// priceDecimals is a number of decimals of the prices given to the submitter
+	priceDecimals = 5
+	// feedValueOffset is added to the feed values, so negative values are encoded as uint32
+	feedValueOffset = int64(1) << 31
+)
+
+// FeedID is a FTSOv2 feed ID
+type FeedID [feedIDLength]byte
+
+// String is used to get FeedID hex value
+func (id FeedID) String() string {
+	return fmt.Sprintf("0x%x", id[:])
+}
+
+// Feed is a FTSOv2 feed in the reward epoch canonical feeds order
+type Feed struct {
+	ID FeedID
+	// Name is the feed name, e.g. "BTC/USD"
+	Name string
+	// Decimals is a number of decimals of the feed value
+	Decimals int
+	// Token is the token submitted for the feed. It is UnknownToken if the feed is not submitted, its value is
+	// encoded as empty then
+	Token contracts.TokenID
+}
+
+// NewFeedID is used to get the crypto feed ID from the feed name, e.g. "BTC/USD"
+func NewFeedID(name string) (FeedID, error) {
+	id := FeedID{}
+	if len(name) > feedIDLength-1 {
+		return id, fmt.Errorf("feed name %s is longer than %v bytes", name, feedIDLength-1)
+	}
+
+	id[0] = cryptoCategory
+	copy(id[1:], name)
+
+	return id, nil
+}
+
+// ParseFeeds is used to parse the canonical feeds order from the NAME=DECIMALS config values, e.g. "BTC/USD=2". Only
+// USD feeds of the known tokens are submitted
+func ParseFeeds(values []string) ([]*Feed, error) {
+	feeds := make([]*Feed, 0, len(values))
+
+	for _, v := range values {
+		name, decimalsS, ok := strings.Cut(v, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid feed %s, NAME=DECIMALS expected", v)
+		}
+
+		decimals, err := strconv.Atoi(decimalsS)
+		if err != nil || decimals < 0 || decimals > 18 {
+			return nil, fmt.Errorf("invalid feed %s decimals", v)
+		}
+
+		name = strings.ToUpper(name)
+		id, err := NewFeedID(name)
+		if err != nil {
+			return nil, err
+		}
+
+		token := contracts.UnknownToken
+		if base, quote, ok := strings.Cut(name, "/"); ok && quote == "USD" {
+			token = contracts.GetTokenIDFromName(base)
+		}
+
+		feeds = append(feeds, &Feed{ID: id, Name: name, Decimals: decimals, Token: token})
+	}
+
+	return feeds, nil
+}
+
+// encodeFeedValues is used to encode the prices of the tokens as the feed values in the feeds order. Each value is
+// 4 bytes: the value with the feed decimals plus 2^31. Feeds without a price are encoded as zero (empty) values
+func encodeFeedValues(feeds []*Feed, tokens []contracts.TokenID, prices []*big.Int) []byte {
+	values := make(map[contracts.TokenID]*big.Int, len(tokens))
+	for i, t := range tokens {
+		values[t] = prices[i]
+	}
+
+	res := make([]byte, 4*len(feeds))
+
+	for i, f := range feeds {
+		price, ok := values[f.Token]
+		if !ok || f.Token == contracts.UnknownToken {
+			continue
+		}
+
+		v := scaleDecimals(price, priceDecimals, f.Decimals)
+		if !v.IsInt64() || v.Int64()+feedValueOffset < 0 || v.Int64()+feedValueOffset > math.MaxUint32 {
+			logger.Log().WithField("layer", "Submission-EncodeFeedValues").Warnf("%s value %v does not fit 4 bytes, feed is not submitted", f.Name, v)
+			continue
+		}
+
+		binary.BigEndian.PutUint32(res[4*i:], uint32(v.Int64()+feedValueOffset))
+	}
+
+	return res
+}
+
+// scaleDecimals is used to change the value decimals. The value is rounded half up when decimals are reduced
+func scaleDecimals(value *big.Int, from int, to int) *big.Int {
+	if to >= from {
+		return new(big.Int).Mul(value, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(to-from)), nil))
+	}
+
+	div := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(from-to)), nil)
+	half := new(big.Int).Div(div, big.NewInt(2))
+
+	return new(big.Int).Div(new(big.Int).Add(value, half), div)
+}
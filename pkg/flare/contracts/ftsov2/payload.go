@@ -0,0 +1,98 @@
+package ftsov2
+
+import (
+	"crypto/ecdsa"
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// FTSOProtocolID is the FTSOv2 Scaling protocol ID in the Flare Systems Protocol
+const FTSOProtocolID = 100
+
+// signatureType is a submitSignatures payload type of the signed merkle root
+const signatureType = 0
+
+// commitArgs are the commit hash abi encoded arguments: submit address, voting round id, random and feed values
+var commitArgs = abi.Arguments{
+	{Type: mustType("address")},
+	{Type: mustType("uint32")},
+	{Type: mustType("bytes32")},
+	{Type: mustType("bytes")},
+}
+
+// mustType is used to get the abi type. It panics on invalid type names, so it is only used with constants
+func mustType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+
+	return typ
+}
+
+// encodePayloadMessage is used to encode the protocol payload sent in the Submission contract calldata: 1 byte
+// protocol id, 4 bytes voting round id, 2 bytes payload length and the payload
+func encodePayloadMessage(protocolID byte, votingRoundID uint32, payload []byte) ([]byte, error) {
+	if len(payload) > math.MaxUint16 {
+		return nil, fmt.Errorf("payload length %v is more than %v", len(payload), math.MaxUint16)
+	}
+
+	res := make([]byte, 7, 7+len(payload))
+	res[0] = protocolID
+	binary.BigEndian.PutUint32(res[1:5], votingRoundID)
+	binary.BigEndian.PutUint16(res[5:7], uint16(len(payload)))
+
+	return append(res, payload...), nil
+}
+
+// commitHash is used to get the commit hash of the feed values: keccak256 of the abi encoded submit address, voting
+// round id, random and feed values
+func commitHash(submitAddress common.Address, votingRoundID uint32, random common.Hash, feedValues []byte) (common.Hash, error) {
+	encoded, err := commitArgs.Pack(submitAddress, votingRoundID, random, feedValues)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+// revealPayload is used to get the reveal payload: the random and the feed values
+func revealPayload(random common.Hash, feedValues []byte) []byte {
+	return append(random.Bytes(), feedValues...)
+}
+
+// resultMessage is used to get the signed voting round result message: 1 byte protocol id, 4 bytes voting round id,
+// 1 byte secure random flag and the results merkle root
+func resultMessage(protocolID byte, votingRoundID uint32, isSecureRandom bool, merkleRoot common.Hash) []byte {
+	res := make([]byte, 6, 38)
+	res[0] = protocolID
+	binary.BigEndian.PutUint32(res[1:5], votingRoundID)
+	if isSecureRandom {
+		res[5] = 1
+	}
+
+	return append(res, merkleRoot.Bytes()...)
+}
+
+// signaturePayload is used to sign the result message and get the submitSignatures payload: 1 byte type, the message
+// and 65 bytes v, r, s signature of the message hash with the ethereum signed message prefix
+func signaturePayload(key *ecdsa.PrivateKey, message []byte) ([]byte, error) {
+	sig, err := crypto.Sign(accounts.TextHash(crypto.Keccak256(message)), key)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]byte, 0, 1+len(message)+65)
+	res = append(res, signatureType)
+	res = append(res, message...)
+	// crypto.Sign returns r, s, v with v in {0, 1}
+	res = append(res, sig[64]+27)
+
+	return append(res, sig[:64]...), nil
+}
@@ -0,0 +1,121 @@
+package ftsov2
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+
+	"oracle-flare/pkg/flare/contracts"
+)
+
+// curveB is the alt_bn128 curve parameter: y^2 = x^3 + 3
+var curveB = big.NewInt(3)
+
+// SortitionKey is the fast updates sortition private key on the alt_bn128 curve. Its public key is registered by
+// the entity and is used to verify the sortition credentials on-chain
+type SortitionKey struct {
+	sk *big.Int
+	pk *bn256.G1
+}
+
+// NewSortitionKey is used to get the sortition key from the hex private key
+func NewSortitionKey(hexKey string) (*SortitionKey, error) {
+	sk, ok := new(big.Int).SetString(strings.TrimPrefix(hexKey, "0x"), 16)
+	if !ok || sk.Sign() <= 0 || sk.Cmp(bn256.Order) >= 0 {
+		return nil, fmt.Errorf("invalid sortition key")
+	}
+
+	return &SortitionKey{sk: sk, pk: new(bn256.G1).ScalarBaseMult(sk)}, nil
+}
+
+// PublicKey is used to get the public key coordinates
+func (k *SortitionKey) PublicKey() (*big.Int, *big.Int) {
+	return g1Coordinates(k.pk)
+}
+
+// credential is used to get the sortition credential of the first replicate below the weight with the score not more
+// than the cutoff. Returns nil if the key is not selected in the block. The proof is computed only for the selected
+// replicate
+func (k *SortitionKey) credential(seed *big.Int, block *big.Int, weight *big.Int, cutoff *big.Int) (*contracts.SortitionCredential, error) {
+	for r := big.NewInt(0); r.Cmp(weight) < 0; r = new(big.Int).Add(r, big.NewInt(1)) {
+		h, err := hashToG1(seed, block, r)
+		if err != nil {
+			return nil, err
+		}
+
+		gamma := new(bn256.G1).ScalarMult(h, k.sk)
+		if x, _ := g1Coordinates(gamma); x.Cmp(cutoff) > 0 {
+			continue
+		}
+
+		return k.prove(h, gamma, r)
+	}
+
+	return nil, nil
+}
+
+// prove is used to get the VRF proof of gamma = sk * h: c = keccak256(g, h, pk, gamma, k * g, k * h) and
+// s = k - c * sk, so the verifier checks c with s * g + c * pk and s * h + c * gamma
+func (k *SortitionKey) prove(h *bn256.G1, gamma *bn256.G1, replicate *big.Int) (*contracts.SortitionCredential, error) {
+	nonce, err := rand.Int(rand.Reader, bn256.Order)
+	if err != nil {
+		return nil, err
+	}
+
+	u := new(bn256.G1).ScalarBaseMult(nonce)
+	v := new(bn256.G1).ScalarMult(h, nonce)
+
+	c := new(big.Int).SetBytes(crypto.Keccak256(
+		new(bn256.G1).ScalarBaseMult(big.NewInt(1)).Marshal(), h.Marshal(), k.pk.Marshal(), gamma.Marshal(),
+		u.Marshal(), v.Marshal(),
+	))
+	c.Mod(c, bn256.Order)
+
+	s := new(big.Int).Sub(nonce, new(big.Int).Mul(c, k.sk))
+	s.Mod(s, bn256.Order)
+
+	x, y := g1Coordinates(gamma)
+
+	return &contracts.SortitionCredential{Replicate: replicate, GammaX: x, GammaY: y, C: c, S: s}, nil
+}
+
+// hashToG1 is used to map the seed, block and replicate to the curve point with try-and-increment: x starts at
+// keccak256 of the 32 bytes values and is incremented until x^3 + 3 has a square root
+func hashToG1(seed *big.Int, block *big.Int, replicate *big.Int) (*bn256.G1, error) {
+	x := new(big.Int).SetBytes(crypto.Keccak256(
+		common.BigToHash(seed).Bytes(), common.BigToHash(block).Bytes(), common.BigToHash(replicate).Bytes(),
+	))
+	x.Mod(x, bn256.P)
+
+	for {
+		rhs := new(big.Int).Exp(x, big.NewInt(3), bn256.P)
+		rhs.Add(rhs, curveB).Mod(rhs, bn256.P)
+
+		if y := new(big.Int).ModSqrt(rhs, bn256.P); y != nil {
+			buf := make([]byte, 64)
+			x.FillBytes(buf[:32])
+			y.FillBytes(buf[32:])
+
+			p := new(bn256.G1)
+			if _, err := p.Unmarshal(buf); err != nil {
+				return nil, err
+			}
+
+			return p, nil
+		}
+
+		x.Add(x, big.NewInt(1)).Mod(x, bn256.P)
+	}
+}
+
+// g1Coordinates is used to get the affine coordinates of the curve point
+func g1Coordinates(p *bn256.G1) (*big.Int, *big.Int) {
+	buf := p.Marshal()
+
+	return new(big.Int).SetBytes(buf[:32]), new(big.Int).SetBytes(buf[32:])
+}
@@ -0,0 +1,373 @@
+package ftsov2
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/event"
+	"go.opentelemetry.io/otel/attribute"
+
+	ftsov2_abi "oracle-flare/abis/ftsov2"
+	"oracle-flare/pkg/alerts"
+	"oracle-flare/pkg/flare/contracts"
+	"oracle-flare/pkg/logger"
+	"oracle-flare/pkg/tracing"
+	"oracle-flare/utils/contractUtils"
+)
+
+const (
+	// confirmTimeout is a max time to wait for the submission tx receipt
+	confirmTimeout = time.Minute * 2
+	// signTimeout is a max time to wait for the voting round result to sign
+	signTimeout = time.Minute * 2
+	// resultRetryInterval is an interval between the voting round result requests
+	resultRetryInterval = time.Second * 5
+	// maxEvents is a max number of the confirmed submissions kept for FilterSubmissions
+	maxEvents = 100
+)
+
+// Signing is the signing phase settings. The voting round result merkle root is taken from the FTSO scaling
+// calculator at ResultURL, signed with the Key and submitted unless it is already finalized on the Relay
+type Signing struct {
+	// Key is the signing policy private key
+	Key *ecdsa.PrivateKey
+	// ResultURL is the voting round result url template, {votingRoundId} is replaced with the voting round id
+	ResultURL string
+	// Relay is the Relay smart-contract address
+	Relay common.Address
+}
+
+// result is the voting round result response of the FTSO scaling calculator
+type result struct {
+	MerkleRoot     common.Hash `json:"merkleRoot"`
+	IsSecureRandom bool        `json:"isSecureRandom"`
+}
+
+// submission is a Submission smart-contract struct of the FTSOv2 Scaling protocol, implementing contracts.IPriceSubmitter
+// interface. Commits are sent with submit1, reveals with submit2 and the signed results with submitSignatures. The
+// Submission contract has no events, so submissions are confirmed by their receipts
+type submission struct {
+	address common.Address
+	signer  contracts.Transactor
+	feeds   []*Feed
+	signing *Signing
+	abi     *abi.ABI
+	// contract is bound with the ABI only, the submit methods calldata is the method ID followed by the raw protocol
+	// payload, so the typed binding can not be used for it
+	contract *bind.BoundContract
+	relay    *ftsov2_abi.Relay
+	provider *ethclient.Client
+	http     *http.Client
+
+	confirmed *confirmations
+}
+
+// confirmations are the confirmed submissions and their watchers. They are shared by the submission instances created
+// on the reward epoch change, so the watchers and the pending confirmations of the previous instance are kept
+type confirmations struct {
+	// mu guards the events and sinks
+	mu sync.Mutex
+	// events are the last confirmed submissions
+	events []*contracts.SubmissionEvent
+	sinks  map[chan<- *contracts.SubmissionEvent]struct{}
+}
+
+// NewSubmission is used to get new submission instance. Feeds are the reward epoch canonical feeds order. The signing
+// phase is skipped if signing is nil or has no result url. The confirmed submissions and watchers of the prev
+// submission are taken over if it is given
+func NewSubmission(
+	provider *ethclient.Client, address common.Address, signer contracts.Transactor, feeds []*Feed, signing *Signing,
+	prev contracts.IPriceSubmitter,
+) (contracts.IPriceSubmitter, error) {
+	c := &submission{
+		provider: provider,
+		address:  address,
+		signer:   signer,
+		feeds:    feeds,
+		signing:  signing,
+		http:     &http.Client{Timeout: resultRetryInterval},
+	}
+
+	if p, ok := prev.(*submission); ok {
+		c.confirmed = p.confirmed
+	} else {
+		c.confirmed = &confirmations{sinks: make(map[chan<- *contracts.SubmissionEvent]struct{})}
+	}
+
+	if err := c.init(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// init is used to create new smart-contract instances
+func (c *submission) init() error {
+	abiI, contract, err := contractUtils.GetContract(ftsov2_abi.ISubmission, c.address, c.provider, c.provider)
+	if err != nil {
+		return fmt.Errorf("get submission contract: %w", err)
+	}
+
+	c.abi = abiI
+	c.contract = contract
+
+	if c.signing == nil || c.signing.ResultURL == "" {
+		logger.Log().WithField("layer", "Submission-Init").Warnln("no voting round result url, signing phase is skipped")
+		return nil
+	}
+
+	relay, err := ftsov2_abi.NewRelay(c.signing.Relay, c.provider)
+	if err != nil {
+		return fmt.Errorf("get relay contract: %w", err)
+	}
+
+	c.relay = relay
+
+	return nil
+}
+
+// CommitPrices is used to send the commit hash of the feed values with submit1
+func (c *submission) CommitPrices(ctx context.Context, epochID *big.Int, indices []contracts.TokenID, prices []*big.Int, random *big.Int) error {
+	signer := c.signer()
+	feedValues := encodeFeedValues(c.feeds, indices, prices)
+
+	_, hashSpan := tracing.Start(ctx, "commitHash")
+	hash, err := commitHash(signer.From, uint32(epochID.Uint64()), common.BigToHash(random), feedValues)
+	tracing.End(hashSpan, err)
+	if err != nil {
+		logger.WithLayer("Submission-CommitPrices", logger.Fields{logger.FieldEpochID: epochID}).Errorln("err get hash:", err.Error())
+		return err
+	}
+
+	sendCtx, span := tracing.Start(ctx, "submit1 send")
+	signer.Context = sendCtx
+
+	tx, err := c.submit(signer, "submit1", uint32(epochID.Uint64()), hash.Bytes())
+	tracing.End(span, err)
+	if err != nil {
+		logger.WithLayer("Submission-CommitPrices", logger.Fields{logger.FieldEpochID: epochID}).Errorf("epochID: %v err tx: %s", epochID, err.Error())
+		return err
+	}
+
+	logger.WithLayer("Submission-CommitPrices", contracts.TxFields(tx, epochID)).Infof("submit1 votingRoundId: %v tx hash: %v", epochID, tx.Hash())
+	go c.confirm(ctx, tx, "submit1", contracts.HashSubmitted, epochID)
+
+	return nil
+}
+
+// RevealPrices is used to send the random and the feed values with submit2 and start the signing phase
+func (c *submission) RevealPrices(ctx context.Context, epochID *big.Int, indices []contracts.TokenID, prices []*big.Int, random *big.Int) error {
+	payload := revealPayload(common.BigToHash(random), encodeFeedValues(c.feeds, indices, prices))
+
+	sendCtx, span := tracing.Start(ctx, "submit2 send")
+	signer := c.signer()
+	signer.Context = sendCtx
+
+	tx, err := c.submit(signer, "submit2", uint32(epochID.Uint64()), payload)
+	tracing.End(span, err)
+	if err != nil {
+		logger.WithLayer("Submission-RevealPrices", logger.Fields{logger.FieldEpochID: epochID}).Errorf("epochID: %v err tx: %s", epochID, err.Error())
+		return err
+	}
+
+	logger.WithLayer("Submission-RevealPrices", contracts.TxFields(tx, epochID)).Infof("submit2 votingRoundId: %v tx hash: %v", epochID, tx.Hash())
+	go c.confirm(ctx, tx, "submit2", contracts.PricesRevealed, epochID)
+
+	if c.relay != nil {
+		go c.sign(ctx, uint32(epochID.Uint64()))
+	}
+
+	return nil
+}
+
+// FilterSubmissions is used to get the confirmed submissions in the given blocks range. Only the submissions sent by
+// this instance are known, so the address is not used
+func (c *submission) FilterSubmissions(_ common.Address, from uint64, to uint64) ([]*contracts.SubmissionEvent, error) {
+	c.confirmed.mu.Lock()
+	defer c.confirmed.mu.Unlock()
+
+	events := []*contracts.SubmissionEvent{}
+	for _, e := range c.confirmed.events {
+		if e.BlockNumber >= from && e.BlockNumber <= to {
+			events = append(events, e)
+		}
+	}
+
+	return events, nil
+}
+
+// WatchSubmissions is used to subscribe to the confirmed submissions. Only the submissions sent by this instance are
+// known, so the address is not used
+func (c *submission) WatchSubmissions(_ common.Address, sink chan<- *contracts.SubmissionEvent) (event.Subscription, error) {
+	c.confirmed.mu.Lock()
+	c.confirmed.sinks[sink] = struct{}{}
+	c.confirmed.mu.Unlock()
+
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		<-quit
+
+		c.confirmed.mu.Lock()
+		delete(c.confirmed.sinks, sink)
+		c.confirmed.mu.Unlock()
+
+		return nil
+	}), nil
+}
+
+// submit is used to send the Submission contract method with the protocol payload message in the calldata
+func (c *submission) submit(signer *bind.TransactOpts, method string, votingRoundID uint32, payload []byte) (*types.Transaction, error) {
+	msg, err := encodePayloadMessage(FTSOProtocolID, votingRoundID, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	// the method ID is copied, it shares the array with its hash
+	data := append(append([]byte{}, c.abi.Methods[method].ID...), msg...)
+
+	return c.contract.RawTransact(signer, data)
+}
+
+// confirm is used to wait for the submission receipt and notify the watchers if it is successful. The receipt span of
+// the method is a child of the epoch trace ctx
+func (c *submission) confirm(ctx context.Context, tx *types.Transaction, method string, t contracts.SubmissionEventType, epochID *big.Int) {
+	ctx, span := tracing.Start(ctx, fmt.Sprintf("%s receipt", method), tracing.Tx(tx.Hash().Hex(), tx.Nonce())...)
+	ctx, cancel := context.WithTimeout(ctx, confirmTimeout)
+	defer cancel()
+
+	receipt, err := bind.WaitMined(ctx, c.provider, tx)
+	if err != nil {
+		tracing.End(span, err)
+		logger.WithLayer("Submission-Confirm", contracts.TxFields(tx, epochID)).Warnf("votingRoundId: %v err wait %s tx %v: %s", epochID, t, tx.Hash(), err.Error())
+		return
+	}
+
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		tracing.End(span, fmt.Errorf("tx reverted"))
+
+		msg := fmt.Sprintf("votingRoundId: %v %s tx %v reverted", epochID, t, tx.Hash())
+		logger.WithLayer("Submission-Confirm", contracts.TxFields(tx, epochID)).WithField("alert", alerts.TxReverted.String()).Error(msg)
+		alerts.Notify(alerts.TxReverted, "Submission-Confirm", t.String(), msg)
+		return
+	}
+
+	tracing.End(span, nil)
+
+	e := &contracts.SubmissionEvent{Type: t, EpochID: epochID, TxHash: tx.Hash(), BlockNumber: receipt.BlockNumber.Uint64()}
+
+	c.confirmed.mu.Lock()
+	c.confirmed.events = append(c.confirmed.events, e)
+	if len(c.confirmed.events) > maxEvents {
+		c.confirmed.events = c.confirmed.events[len(c.confirmed.events)-maxEvents:]
+	}
+
+	sinks := make([]chan<- *contracts.SubmissionEvent, 0, len(c.confirmed.sinks))
+	for s := range c.confirmed.sinks {
+		sinks = append(sinks, s)
+	}
+	c.confirmed.mu.Unlock()
+
+	for _, s := range sinks {
+		select {
+		case s <- e:
+		case <-time.After(resultRetryInterval):
+			logger.Log().WithField("layer", "Submission-Confirm").Warnf("votingRoundId: %v %s watcher is busy", epochID, t)
+		}
+	}
+}
+
+// sign is used to wait for the voting round result, sign its merkle root and send it with submitSignatures. The sign
+// span is a child of the epoch trace ctx
+func (c *submission) sign(ctx context.Context, votingRoundID uint32) {
+	ctx, span := tracing.Start(ctx, "sign")
+	deadline := time.Now().Add(signTimeout)
+
+	var res *result
+	for {
+		r, err := c.fetchResult(votingRoundID)
+		if err == nil {
+			res = r
+			break
+		}
+
+		if time.Now().After(deadline) {
+			tracing.End(span, err)
+			logger.WithLayer("Submission-Sign", logger.Fields{logger.FieldEpochID: votingRoundID}).Errorf("votingRoundId: %v no result to sign: %s", votingRoundID, err.Error())
+			return
+		}
+
+		time.Sleep(resultRetryInterval)
+	}
+
+	finalizedCtx, finalizedSpan := tracing.Start(ctx, "finalization check")
+	finalized, err := c.relay.MerkleRoots(&bind.CallOpts{Context: finalizedCtx}, big.NewInt(FTSOProtocolID), big.NewInt(int64(votingRoundID)))
+	finalizedSpan.SetAttributes(attribute.Bool("finalized", err == nil && finalized != [32]byte{}))
+	tracing.End(finalizedSpan, err)
+	if err != nil {
+		logger.WithLayer("Submission-Sign", logger.Fields{logger.FieldEpochID: votingRoundID}).Warnf("votingRoundId: %v err get finalized root: %s", votingRoundID, err.Error())
+	} else if finalized != [32]byte{} {
+		if common.Hash(finalized) != res.MerkleRoot {
+			logger.WithLayer("Submission-Sign", logger.Fields{logger.FieldEpochID: votingRoundID}).Errorf("votingRoundId: %v finalized root %x differs from the result %v", votingRoundID, finalized, res.MerkleRoot)
+		}
+
+		logger.WithLayer("Submission-Sign", logger.Fields{logger.FieldEpochID: votingRoundID}).Infof("votingRoundId: %v is already finalized, not signed", votingRoundID)
+		tracing.End(span, nil)
+		return
+	}
+
+	payload, err := signaturePayload(c.signing.Key, resultMessage(FTSOProtocolID, votingRoundID, res.IsSecureRandom, res.MerkleRoot))
+	if err != nil {
+		logger.WithLayer("Submission-Sign", logger.Fields{logger.FieldEpochID: votingRoundID}).Errorf("votingRoundId: %v err sign: %s", votingRoundID, err.Error())
+		tracing.End(span, err)
+		return
+	}
+
+	signer := c.signer()
+	signer.Context = ctx
+
+	tx, err := c.submit(signer, "submitSignatures", votingRoundID, payload)
+	tracing.End(span, err)
+	if err != nil {
+		logger.WithLayer("Submission-Sign", logger.Fields{logger.FieldEpochID: votingRoundID}).Errorf("votingRoundId: %v err tx: %s", votingRoundID, err.Error())
+		return
+	}
+
+	logger.WithLayer("Submission-Sign", contracts.TxFields(tx, new(big.Int).SetUint64(uint64(votingRoundID)))).Infof("submitSignatures votingRoundId: %v root: %v tx hash: %v", votingRoundID, res.MerkleRoot, tx.Hash())
+}
+
+// fetchResult is used to get the voting round result from the FTSO scaling calculator
+func (c *submission) fetchResult(votingRoundID uint32) (*result, error) {
+	url := strings.ReplaceAll(c.signing.ResultURL, "{votingRoundId}", fmt.Sprint(votingRoundID))
+
+	resp, err := c.http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+
+	res := &result{}
+	if err := json.NewDecoder(resp.Body).Decode(res); err != nil {
+		return nil, fmt.Errorf("decode result: %w", err)
+	}
+
+	if res.MerkleRoot == (common.Hash{}) {
+		return nil, fmt.Errorf("empty merkle root")
+	}
+
+	return res, nil
+}
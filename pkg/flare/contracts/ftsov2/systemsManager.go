@@ -0,0 +1,123 @@
+package ftsov2
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	ftsov2_abi "oracle-flare/abis/ftsov2"
+	"oracle-flare/pkg/flare/contracts"
+)
+
+// systemsManager is a FlareSystemsManager smart-contract struct, implementing contracts.IFTSOManager interface. Price
+// epochs are the FTSOv2 voting rounds: prices of the round are committed during it and revealed in the first half of
+// the next round
+type systemsManager struct {
+	address  common.Address
+	contract *ftsov2_abi.FlareSystemsManager
+	provider *ethclient.Client
+
+	// mu guards the voting rounds timing. It is immutable, so it is loaded once
+	mu         sync.Mutex
+	firstStart uint64
+	duration   uint64
+}
+
+// NewSystemsManager is used to get new systemsManager instance
+func NewSystemsManager(provider *ethclient.Client, address common.Address) (contracts.IFTSOManager, error) {
+	c := &systemsManager{
+		provider: provider,
+		address:  address,
+	}
+
+	if err := c.init(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// init is used to create new smart-contract instance
+func (c *systemsManager) init() error {
+	contract, err := ftsov2_abi.NewFlareSystemsManager(c.address, c.provider)
+	if err != nil {
+		return fmt.Errorf("get flare systems manager contract: %w", err)
+	}
+
+	c.contract = contract
+
+	return nil
+}
+
+// timing is used to get the first voting round start timestamp and the voting epoch duration
+func (c *systemsManager) timing() (uint64, uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.duration > 0 {
+		return c.firstStart, c.duration, nil
+	}
+
+	firstStart, err := c.contract.FirstVotingRoundStartTs(&bind.CallOpts{})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	duration, err := c.contract.VotingEpochDurationSeconds(&bind.CallOpts{})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if duration == 0 {
+		return 0, 0, fmt.Errorf("voting epoch duration is 0")
+	}
+
+	c.firstStart, c.duration = firstStart, duration
+
+	return c.firstStart, c.duration, nil
+}
+
+// GetCurrentPriceEpochData is used to get the current voting round data by the latest block timestamp. The reveal end
+// is the reveal deadline in the middle of the next round
+func (c *systemsManager) GetCurrentPriceEpochData() (*contracts.PriceEpochData, error) {
+	firstStart, duration, err := c.timing()
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := c.provider.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	round := (head.Time - firstStart) / duration
+	start := firstStart + round*duration
+
+	return &contracts.PriceEpochData{
+		EpochID:            new(big.Int).SetUint64(round),
+		StartTimestamp:     new(big.Int).SetUint64(start),
+		EndTimestamp:       new(big.Int).SetUint64(start + duration),
+		RevealEndTimestamp: new(big.Int).SetUint64(start + duration + duration/2),
+		CurrentTimestamp:   new(big.Int).SetUint64(head.Time),
+	}, nil
+}
+
+// GetCurrentRewardEpoch is used to get current reward epoch id
+func (c *systemsManager) GetCurrentRewardEpoch() (*big.Int, error) {
+	return c.contract.GetCurrentRewardEpochId(&bind.CallOpts{})
+}
+
+// GetRewardEpochVotePowerBlock is used to get the vote power block for given reward epoch
+func (c *systemsManager) GetRewardEpochVotePowerBlock(rewardEpoch *big.Int) (*big.Int, error) {
+	block, err := c.contract.GetVotePowerBlock(&bind.CallOpts{}, rewardEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetUint64(block), nil
+}
@@ -0,0 +1,18 @@
+// Package localChain registers the local flare devnet. It is deployed with the flare smart-contracts, so the flare
+// main-net adapter is reused with the devnet chain ID
+package localChain
+
+import (
+	"oracle-flare/pkg/flare/contracts"
+	"oracle-flare/pkg/flare/contracts/flareChain"
+)
+
+func init() {
+	contracts.RegisterChain(&contracts.ChainAdapter{
+		ID:           162,
+		Name:         "LocalChain",
+		Protocol:     "ftsov1",
+		NativeSymbol: "FLR",
+		NewContracts: flareChain.NewContracts,
+	})
+}
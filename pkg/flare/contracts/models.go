@@ -1,6 +1,34 @@
 package contracts
 
-import "math/big"
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"oracle-flare/pkg/logger"
+)
+
+// Transactor is used to get the signer transaction options with the current gas settings. New options are returned
+// on each call, so they can be changed by the transaction without affecting the others
+type Transactor func() *bind.TransactOpts
+
+// TxFields is used to get the log fields of the signer transaction: the tx hash, nonce and chain ID. The epoch ID is
+// added if it is not nil
+func TxFields(tx *types.Transaction, epochID *big.Int) logger.Fields {
+	fields := logger.Fields{
+		logger.FieldTxHash:  tx.Hash().Hex(),
+		logger.FieldNonce:   tx.Nonce(),
+		logger.FieldChainID: tx.ChainId(),
+	}
+
+	if epochID != nil {
+		fields[logger.FieldEpochID] = epochID
+	}
+
+	return fields
+}
 
 // PriceEpochData is a getCurrentPriceEpochData method response model
 type PriceEpochData struct {
@@ -11,8 +39,78 @@ type PriceEpochData struct {
 	CurrentTimestamp   *big.Int
 }
 
+// CurrentPrice is a getCurrentPriceWithDecimals method response model
+type CurrentPrice struct {
+	// Price is the last finalized price in USD with Decimals
+	Price     *big.Int
+	Timestamp *big.Int
+	Decimals  *big.Int
+}
+
 // IndicesAndSymbols is a getIndicesAndSymbols method response model
 type IndicesAndSymbols struct {
 	Indices []*big.Int
 	Symbols []string
 }
+
+// SubmissionEventType is a PriceSubmitter smart-contract event type
+type SubmissionEventType int
+
+const (
+	UnknownSubmission SubmissionEventType = iota
+	HashSubmitted
+	PricesRevealed
+)
+
+var SubmissionEventTypeStrings = [...]string{
+	UnknownSubmission: "UnknownSubmission",
+	HashSubmitted:     "HashSubmitted",
+	PricesRevealed:    "PricesRevealed",
+}
+
+// String is used to get SubmissionEventType string value
+func (t SubmissionEventType) String() string {
+	return SubmissionEventTypeStrings[t]
+}
+
+// SubmissionEvent is a PriceSubmitter hash submitted or prices revealed event model
+type SubmissionEvent struct {
+	Type        SubmissionEventType
+	EpochID     *big.Int
+	TxHash      common.Hash
+	BlockNumber uint64
+}
+
+// FastUpdateFeed is a fetchCurrentFeeds method response model of the single FTSOv2 fast updates feed
+type FastUpdateFeed struct {
+	// Index is the feed index in the FastUpdater
+	Index int
+	// Name is the feed name, e.g. "BTC/USD"
+	Name string
+	// Token is the token of the USD feed. It is UnknownToken for the other feeds
+	Token TokenID
+	// Value is the current feed value with Decimals
+	Value    *big.Int
+	Decimals int
+}
+
+// SortitionCredential is a verifiable proof that the provider is selected to submit the fast updates in the block
+type SortitionCredential struct {
+	// Replicate is the selected replicate of the provider sortition weight
+	Replicate *big.Int
+	// GammaX and GammaY are the VRF output point. GammaX is the sortition score
+	GammaX *big.Int
+	GammaY *big.Int
+	// C and S are the VRF proof
+	C *big.Int
+	S *big.Int
+}
+
+// FastUpdate is a submitUpdates method request model
+type FastUpdate struct {
+	// SortitionBlock is the block the provider is selected in
+	SortitionBlock *big.Int
+	Credential     *SortitionCredential
+	// Deltas are the feed value changes in the feeds order: 1, -1 or 0
+	Deltas []int8
+}
@@ -0,0 +1,55 @@
+package songbirdChain
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"oracle-flare/pkg/flare/contracts"
+)
+
+func init() {
+	contracts.RegisterChain(&contracts.ChainAdapter{
+		ID:           19,
+		Name:         "SongBirdChain",
+		Protocol:     "ftsov1",
+		NativeSymbol: "FLR",
+		NewContracts: NewContracts,
+	})
+
+	// Coston is the Songbird test-net with the same ABI
+	contracts.RegisterChain(&contracts.ChainAdapter{
+		ID:           16,
+		Name:         "CostonChain",
+		Protocol:     "ftsov1",
+		SymbolPrefix: "test",
+		NativeSymbol: "CFLR",
+		NewContracts: NewContracts,
+	})
+}
+
+// NewContracts is used to create the Songbird smart-contracts. VoterWhitelister is not used on Songbird
+func NewContracts(
+	provider *ethclient.Client, address func(name string) (common.Address, error), signer contracts.Transactor,
+	tokens *contracts.TokenTable,
+) (*contracts.ChainContracts, error) {
+	submitterAddress, err := address("PriceSubmitter")
+	if err != nil {
+		return nil, err
+	}
+
+	managerAddress, err := address("FtsoManager")
+	if err != nil {
+		return nil, err
+	}
+
+	registryAddress, err := address("FtsoRegistry")
+	if err != nil {
+		return nil, err
+	}
+
+	return &contracts.ChainContracts{
+		PriceSubmitter: NewPriceSubmitter(provider, submitterAddress, signer, tokens),
+		FTSOManager:    NewFTSOManager(provider, managerAddress),
+		FTSORegistry:   NewFTSORegistry(provider, registryAddress, tokens),
+	}, nil
+}
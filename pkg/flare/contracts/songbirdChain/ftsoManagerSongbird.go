@@ -3,7 +3,6 @@ package songbirdChain
 import (
 	"math/big"
 
-	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
@@ -11,14 +10,12 @@ import (
 	songbird_abi "oracle-flare/abis/songbird"
 	"oracle-flare/pkg/flare/contracts"
 	"oracle-flare/pkg/logger"
-	"oracle-flare/utils/contractUtils"
 )
 
 // ftsoManager is a FtsoManager songbird-net smart-contract struct, implementing contracts.IFTSOManager interface
 type ftsoManager struct {
 	address  common.Address
-	abi      *abi.ABI
-	contract *bind.BoundContract
+	contract *songbird_abi.FtsoManager
 	provider *ethclient.Client
 }
 
@@ -36,30 +33,36 @@ func NewFTSOManager(provider *ethclient.Client, address common.Address) contract
 
 // init is used to create new smart-contract instance
 func (c *ftsoManager) init() {
-	abiI, contract, err := contractUtils.GetContract(songbird_abi.IFtsoManager, c.address, c.provider, c.provider)
+	contract, err := songbird_abi.NewFtsoManager(c.address, c.provider)
 	if err != nil {
 		logger.Log().WithField("layer", "FTSO-Init").Fatalln("err get contract:", err.Error())
 	}
 
-	c.abi = abiI
 	c.contract = contract
 }
 
 // GetCurrentPriceEpochData is used to get and parse current epoch data
 func (c *ftsoManager) GetCurrentPriceEpochData() (*contracts.PriceEpochData, error) {
-	out := []interface{}{}
-
-	if err := c.contract.Call(&bind.CallOpts{}, &out, "getCurrentPriceEpochData"); err != nil {
+	out, err := c.contract.GetCurrentPriceEpochData(&bind.CallOpts{})
+	if err != nil {
 		return nil, err
 	}
 
-	p := &contracts.PriceEpochData{}
+	return &contracts.PriceEpochData{
+		EpochID:            out.PriceEpochId,
+		StartTimestamp:     out.PriceEpochStartTimestamp,
+		EndTimestamp:       out.PriceEpochEndTimestamp,
+		RevealEndTimestamp: out.PriceEpochRevealEndTimestamp,
+		CurrentTimestamp:   out.CurrentTimestamp,
+	}, nil
+}
 
-	p.EpochID = abi.ConvertType(out[0], new(big.Int)).(*big.Int)
-	p.StartTimestamp = abi.ConvertType(out[1], new(big.Int)).(*big.Int)
-	p.EndTimestamp = abi.ConvertType(out[2], new(big.Int)).(*big.Int)
-	p.RevealEndTimestamp = abi.ConvertType(out[3], new(big.Int)).(*big.Int)
-	p.CurrentTimestamp = abi.ConvertType(out[4], new(big.Int)).(*big.Int)
+// GetCurrentRewardEpoch is used to get current reward epoch id
+func (c *ftsoManager) GetCurrentRewardEpoch() (*big.Int, error) {
+	return c.contract.GetCurrentRewardEpoch(&bind.CallOpts{})
+}
 
-	return p, nil
+// GetRewardEpochVotePowerBlock is used to get the vote power block for given reward epoch
+func (c *ftsoManager) GetRewardEpochVotePowerBlock(rewardEpoch *big.Int) (*big.Int, error) {
+	return c.contract.GetRewardEpochVotePowerBlock(&bind.CallOpts{}, rewardEpoch)
 }
@@ -1,33 +1,54 @@
 package songbirdChain
 
 import (
-	"log"
+	"context"
+	"fmt"
 	"math/big"
+	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/event"
+	"go.opentelemetry.io/otel/attribute"
 
 	songbird_abi "oracle-flare/abis/songbird"
+	"oracle-flare/pkg/alerts"
 	"oracle-flare/pkg/flare/contracts"
 	"oracle-flare/pkg/logger"
-	"oracle-flare/utils/contractUtils"
+	"oracle-flare/pkg/tracing"
+	"oracle-flare/utils/abiCoder"
 )
 
+// receiptTimeout is a max time to wait for the submission tx receipt
+const receiptTimeout = time.Minute * 2
+
+// submissionMethods are the PriceSubmitter method names of the submission types used in the span names
+var submissionMethods = map[contracts.SubmissionEventType]string{
+	contracts.HashSubmitted:  "submitPriceHashes",
+	contracts.PricesRevealed: "revealPrices",
+}
+
 // priceSubmitter is a PriceSubmitter songbird-net smart-contract struct, implementing contracts.IPriceSubmitter interface
 type priceSubmitter struct {
 	address  common.Address
+	signer   contracts.Transactor
+	tokens   *contracts.TokenTable
 	abi      *abi.ABI
-	contract *bind.BoundContract
+	contract *songbird_abi.PriceSubmitter
 	provider *ethclient.Client
 }
 
 // NewPriceSubmitter is used to get new priceSubmitter instance
-func NewPriceSubmitter(provider *ethclient.Client, address common.Address) contracts.IPriceSubmitter {
+func NewPriceSubmitter(provider *ethclient.Client, address common.Address, signer contracts.Transactor, tokens *contracts.TokenTable) contracts.IPriceSubmitter {
 	c := &priceSubmitter{
 		provider: provider,
 		address:  address,
+		signer:   signer,
+		tokens:   tokens,
 	}
 
 	c.init()
@@ -37,7 +58,12 @@ func NewPriceSubmitter(provider *ethclient.Client, address common.Address) contr
 
 // init is used to create new smart-contract instance
 func (c *priceSubmitter) init() {
-	abiI, contract, err := contractUtils.GetContract(songbird_abi.IPriceSubmitter, c.address, c.provider, c.provider)
+	abiI, err := songbird_abi.PriceSubmitterMetaData.GetAbi()
+	if err != nil {
+		logger.Log().WithField("layer", "PriceSubmitter-Init").Fatalln("err get abi:", err.Error())
+	}
+
+	contract, err := songbird_abi.NewPriceSubmitter(c.address, c.provider)
 	if err != nil {
 		logger.Log().WithField("layer", "PriceSubmitter-Init").Fatalln("err get contract:", err.Error())
 	}
@@ -46,18 +72,224 @@ func (c *priceSubmitter) init() {
 	c.contract = contract
 }
 
-// CommitPrices is used to hash and commit given data
-func (c *priceSubmitter) CommitPrices(epochID *big.Int, indices []contracts.TokenID, prices []*big.Int, random *big.Int) error {
-	//TODO: implement
-	log.Printf("received commit epoch:%v random:%v price:%v", epochID.Uint64(), random.Uint64(), prices[0].Uint64())
+// CommitPrices is used to hash and commit given data. Songbird PriceSubmitter takes a hash for each FTSO: the price,
+// the random and the signer address are hashed
+func (c *priceSubmitter) CommitPrices(ctx context.Context, epochID *big.Int, indices []contracts.TokenID, prices []*big.Int, random *big.Int) error {
+	if len(indices) == 0 || len(indices) != len(prices) {
+		return fmt.Errorf("invalid prices: %v indices, %v prices", len(indices), len(prices))
+	}
+
+	coder, err := abiCoder.NewCoder([]string{"uint256", "uint256", "address"})
+	if err != nil {
+		logger.WithLayer("PriceSubmitter-CommitPrices", logger.Fields{logger.FieldEpochID: epochID}).Errorln("err create coder:", err.Error())
+		return err
+	}
+
+	signer := c.signer()
+
+	_, hashSpan := tracing.Start(ctx, "abiCoder.KeccakHash")
+	hashes := make([][32]byte, 0, len(prices))
+	for _, p := range prices {
+		hash, err := coder.KeccakHash(p, random, signer.From)
+		if err != nil {
+			tracing.End(hashSpan, err)
+			logger.WithLayer("PriceSubmitter-CommitPrices", logger.Fields{logger.FieldEpochID: epochID}).Errorln("err get hash:", err.Error())
+			return err
+		}
+
+		hashes = append(hashes, hash)
+	}
+	tracing.End(hashSpan, nil)
+
+	sendCtx, span := tracing.Start(ctx, "submitPriceHashes send")
+	signer.Context = sendCtx
+
+	tx, err := c.contract.SubmitPriceHashes(signer, epochID, c.ftsoIndices(indices), hashes)
+	if err != nil {
+		tracing.End(span, err)
+		logger.WithLayer("PriceSubmitter-CommitPrices", logger.Fields{logger.FieldEpochID: epochID}).Errorln("err tx:", err.Error())
+		return err
+	}
+
+	span.SetAttributes(tracing.Tx(tx.Hash().Hex(), tx.Nonce())...)
+	tracing.End(span, nil)
+
+	logger.WithLayer("PriceSubmitter-CommitPrices", contracts.TxFields(tx, epochID)).Infof("submitPriceHashes epochID: %v tx hash: %v time: %v", epochID, tx.Hash(), tx.Time())
+
+	go c.checkReceipt(ctx, tx, contracts.HashSubmitted, epochID)
 
 	return nil
 }
 
-// RevealPrices is used to reveal given data
-func (c *priceSubmitter) RevealPrices(epochID *big.Int, indices []contracts.TokenID, prices []*big.Int, random *big.Int) error {
-	//TODO: implement
-	log.Printf("received reveal epoch:%v random:%v price:%v", epochID.Uint64(), random.Uint64(), prices[0].Uint64())
+// RevealPrices is used to reveal given data. Songbird PriceSubmitter takes a random for each FTSO, the same random is
+// used for all of them
+func (c *priceSubmitter) RevealPrices(ctx context.Context, epochID *big.Int, indices []contracts.TokenID, prices []*big.Int, random *big.Int) error {
+	if len(indices) == 0 || len(indices) != len(prices) {
+		return fmt.Errorf("invalid prices: %v indices, %v prices", len(indices), len(prices))
+	}
+
+	randoms := make([]*big.Int, len(prices))
+	for i := range randoms {
+		randoms[i] = random
+	}
+
+	sendCtx, span := tracing.Start(ctx, "revealPrices send")
+	signer := c.signer()
+	signer.Context = sendCtx
+
+	tx, err := c.contract.RevealPrices(signer, epochID, c.ftsoIndices(indices), prices, randoms)
+	if err != nil {
+		tracing.End(span, err)
+		logger.WithLayer("PriceSubmitter-RevealPrices", logger.Fields{logger.FieldEpochID: epochID}).Errorf("epochID: %v err tx: %s", epochID, err.Error())
+		return err
+	}
+
+	span.SetAttributes(tracing.Tx(tx.Hash().Hex(), tx.Nonce())...)
+	tracing.End(span, nil)
+
+	logger.WithLayer("PriceSubmitter-RevealPrices", contracts.TxFields(tx, epochID)).Infof("revealPrices epochID: %v tx hash: %v time: %v", epochID, tx.Hash(), tx.Time())
+
+	go c.checkReceipt(ctx, tx, contracts.PricesRevealed, epochID)
 
 	return nil
 }
+
+// ftsoIndices is used to get the chain FTSO indices of the tokens
+func (c *priceSubmitter) ftsoIndices(indices []contracts.TokenID) []*big.Int {
+	res := make([]*big.Int, 0, len(indices))
+	for _, i := range indices {
+		res = append(res, c.tokens.Index(i))
+	}
+
+	return res
+}
+
+// checkReceipt is used to wait for the submission receipt and alert if the tx is reverted. Successful submissions are
+// confirmed by the watched events. The receipt span is a child of the epoch trace ctx
+func (c *priceSubmitter) checkReceipt(ctx context.Context, tx *types.Transaction, t contracts.SubmissionEventType, epochID *big.Int) {
+	ctx, span := tracing.Start(ctx, fmt.Sprintf("%s receipt", submissionMethods[t]), tracing.Tx(tx.Hash().Hex(), tx.Nonce())...)
+	ctx, cancel := context.WithTimeout(ctx, receiptTimeout)
+	defer cancel()
+
+	receipt, err := bind.WaitMined(ctx, c.provider, tx)
+	if err != nil {
+		tracing.End(span, err)
+		logger.WithLayer("PriceSubmitter-Receipt", contracts.TxFields(tx, epochID)).Warnf("epochID: %v err wait %s tx %v: %s", epochID, t, tx.Hash(), err.Error())
+		return
+	}
+
+	span.SetAttributes(attribute.Int64("block_number", receipt.BlockNumber.Int64()), attribute.Int64("gas_used", int64(receipt.GasUsed)))
+
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		tracing.End(span, fmt.Errorf("tx reverted"))
+
+		msg := fmt.Sprintf("epochID: %v %s tx %v reverted", epochID, t, tx.Hash())
+		logger.WithLayer("PriceSubmitter-Receipt", contracts.TxFields(tx, epochID)).WithField("alert", alerts.TxReverted.String()).Error(msg)
+		alerts.Notify(alerts.TxReverted, "PriceSubmitter-Receipt", t.String(), msg)
+		return
+	}
+
+	tracing.End(span, nil)
+}
+
+// FilterSubmissions is used to get PriceHashesSubmitted and PricesRevealed events of given address in the given blocks range
+func (c *priceSubmitter) FilterSubmissions(address common.Address, from uint64, to uint64) ([]*contracts.SubmissionEvent, error) {
+	query := c.submissionsQuery(address)
+	query.FromBlock = new(big.Int).SetUint64(from)
+	query.ToBlock = new(big.Int).SetUint64(to)
+
+	logs, err := c.provider.FilterLogs(context.Background(), query)
+	if err != nil {
+		return nil, err
+	}
+
+	events := []*contracts.SubmissionEvent{}
+	for _, l := range logs {
+		e, err := c.parseSubmission(l)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, e)
+	}
+
+	return events, nil
+}
+
+// WatchSubmissions is used to subscribe to PriceHashesSubmitted and PricesRevealed events of given address
+func (c *priceSubmitter) WatchSubmissions(address common.Address, sink chan<- *contracts.SubmissionEvent) (event.Subscription, error) {
+	logs := make(chan types.Log)
+
+	sub, err := c.provider.SubscribeFilterLogs(context.Background(), c.submissionsQuery(address), logs)
+	if err != nil {
+		return nil, err
+	}
+
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case l := <-logs:
+				e, err := c.parseSubmission(l)
+				if err != nil {
+					return err
+				}
+
+				select {
+				case sink <- e:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// submissionsQuery is used to get filter query for the PriceHashesSubmitted and PricesRevealed events of given address.
+// Both events have the sender address as the first indexed argument
+func (c *priceSubmitter) submissionsQuery(address common.Address) ethereum.FilterQuery {
+	return ethereum.FilterQuery{
+		Addresses: []common.Address{c.address},
+		Topics: [][]common.Hash{
+			{c.abi.Events["PriceHashesSubmitted"].ID, c.abi.Events["PricesRevealed"].ID},
+			{common.BytesToHash(address.Bytes())},
+		},
+	}
+}
+
+// parseSubmission is used to parse PriceHashesSubmitted or PricesRevealed log
+func (c *priceSubmitter) parseSubmission(l types.Log) (*contracts.SubmissionEvent, error) {
+	e := &contracts.SubmissionEvent{
+		TxHash:      l.TxHash,
+		BlockNumber: l.BlockNumber,
+	}
+
+	switch l.Topics[0] {
+	case c.abi.Events["PriceHashesSubmitted"].ID:
+		submitted, err := c.contract.ParsePriceHashesSubmitted(l)
+		if err != nil {
+			return nil, err
+		}
+
+		e.Type = contracts.HashSubmitted
+		e.EpochID = submitted.EpochId
+	case c.abi.Events["PricesRevealed"].ID:
+		revealed, err := c.contract.ParsePricesRevealed(l)
+		if err != nil {
+			return nil, err
+		}
+
+		e.Type = contracts.PricesRevealed
+		e.EpochID = revealed.EpochId
+	default:
+		return nil, fmt.Errorf("unknown event: %s", l.Topics[0].Hex())
+	}
+
+	return e, nil
+}
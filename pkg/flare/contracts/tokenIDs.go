@@ -2,6 +2,7 @@ package contracts
 
 import (
 	"math/big"
+	"sync/atomic"
 )
 
 // TokenID is a flare token id type
@@ -53,80 +54,74 @@ var TokenIDWSNames = [...]string{
 	XRP:          "XRP",
 }
 
-// TokenIDSymbol are the names for smart-contract. Filled on init depends on the chain ID
-var TokenIDSymbol = [...]string{
-	UnknownToken: "UnknownToken",
-	ADA:          "ADA",
-	ALGO:         "ALGO",
-	ARB:          "ARB",
-	AVAX:         "AVAX",
-	BNB:          "BNB",
-	BTC:          "BTC",
-	DOGE:         "DOGE",
-	ETH:          "ETH",
-	FIL:          "FIL",
-	FLR:          "FLR",
-	LTC:          "LTC",
-	MATIC:        "MATIC",
-	SOL:          "SOL",
-	USDC:         "USDC",
-	USDT:         "USDT",
-	XDC:          "XDC",
-	XLM:          "XLM",
-	XRP:          "XRP",
+// TokenTable is a chain TokenID table: the flare indices and the smart-contract symbols of the tokens. Each flare
+// instance has its own table, so the chains with different indices and symbol naming rules can be served by one process
+type TokenTable struct {
+	// state is filled with on-chain values on init and swapped on each reward epoch change
+	state atomic.Pointer[tokenTableState]
+}
+
+// tokenTableState is the TokenID indices and symbols. Indices are -1 for not supported tokens
+type tokenTableState struct {
+	indices []*big.Int
+	symbols []string
 }
 
-var TokenIDIndices = []*big.Int{
-	UnknownToken: big.NewInt(-1),
-	ADA:          big.NewInt(-1),
-	ALGO:         big.NewInt(-1),
-	ARB:          big.NewInt(-1),
-	AVAX:         big.NewInt(-1),
-	BNB:          big.NewInt(-1),
-	BTC:          big.NewInt(-1),
-	DOGE:         big.NewInt(-1),
-	ETH:          big.NewInt(-1),
-	FIL:          big.NewInt(-1),
-	FLR:          big.NewInt(-1),
-	LTC:          big.NewInt(-1),
-	MATIC:        big.NewInt(-1),
-	SOL:          big.NewInt(-1),
-	USDC:         big.NewInt(-1),
-	USDT:         big.NewInt(-1),
-	XDC:          big.NewInt(-1),
-	XLM:          big.NewInt(-1),
-	XRP:          big.NewInt(-1),
+// NewTokenTable is used to get new TokenTable with the WS names as symbols and all tokens not supported
+func NewTokenTable() *TokenTable {
+	t := &TokenTable{}
+	t.state.Store(newTokenTableState("", ""))
+
+	return t
 }
 
-// FillTokenIDAndNames is used to fill the TokenIDIndices with on-chain values and names depend on the chain id
-func FillTokenIDAndNames(data *IndicesAndSymbols, isTestNet bool) {
-	if isTestNet {
-		TokenIDSymbol[ADA] = "testADA"
-		TokenIDSymbol[ALGO] = "testALGO"
-		TokenIDSymbol[ARB] = "testARB"
-		TokenIDSymbol[AVAX] = "testAVAX"
-		TokenIDSymbol[BNB] = "testBNB"
-		TokenIDSymbol[BTC] = "testBTC"
-		TokenIDSymbol[DOGE] = "testDOGE"
-		TokenIDSymbol[ETH] = "testETH"
-		TokenIDSymbol[FIL] = "testFIL"
-		TokenIDSymbol[FLR] = "C2FLR"
-		TokenIDSymbol[LTC] = "testLTC"
-		TokenIDSymbol[MATIC] = "testMATIC"
-		TokenIDSymbol[SOL] = "testSOL"
-		TokenIDSymbol[USDC] = "testUSDC"
-		TokenIDSymbol[USDT] = "testUSDT"
-		TokenIDSymbol[XDC] = "testXDC"
-		TokenIDSymbol[XLM] = "testXLM"
-		TokenIDSymbol[XRP] = "testXRP"
+// newTokenTableState is used to get the state with not supported (-1) indices and the symbols of the naming rules
+func newTokenTableState(symbolPrefix string, nativeSymbol string) *tokenTableState {
+	state := &tokenTableState{
+		indices: make([]*big.Int, len(TokenIDWSNames)),
+		symbols: make([]string, len(TokenIDWSNames)),
+	}
+
+	for i := range state.indices {
+		state.indices[i] = big.NewInt(-1)
+		state.symbols[i] = symbolPrefix + TokenIDWSNames[i]
 	}
 
+	state.symbols[UnknownToken] = TokenIDWSNames[UnknownToken]
+
+	if nativeSymbol != "" {
+		state.symbols[FLR] = nativeSymbol
+	}
+
+	return state
+}
+
+// Fill is used to fill the TokenID indices with on-chain values and the symbols with the network naming rules:
+// symbolPrefix is prepended to the token names, e.g. "test" on the test-nets, and nativeSymbol is used for the FLR
+// token if it is given. The state is replaced at once, so tokens removed on-chain become not supported
+func (t *TokenTable) Fill(data *IndicesAndSymbols, symbolPrefix string, nativeSymbol string) {
+	state := newTokenTableState(symbolPrefix, nativeSymbol)
+
 	for i, s := range data.Symbols {
-		id := GetTokenIDFromSymbol(s)
-		if id != UnknownToken {
-			TokenIDIndices[id] = data.Indices[i]
+		for id, symbol := range state.symbols {
+			if TokenID(id) != UnknownToken && symbol == s {
+				state.indices[id] = data.Indices[i]
+				break
+			}
 		}
 	}
+
+	t.state.Store(state)
+}
+
+// Index is used to get the TokenID flare index. It is -1 for not supported tokens
+func (t *TokenTable) Index(id TokenID) *big.Int {
+	return t.state.Load().indices[id]
+}
+
+// Symbol is used to get the TokenID string value for the smart-contract
+func (t *TokenTable) Symbol(id TokenID) string {
+	return t.state.Load().symbols[id]
 }
 
 // GetTokenIDFromName is used to parse string to the TokenID from given WS name
@@ -173,61 +168,7 @@ func GetTokenIDFromName(s string) TokenID {
 	}
 }
 
-// GetTokenIDFromSymbol is used to parse string to the TokenID from given smart-contract symbol
-func GetTokenIDFromSymbol(s string) TokenID {
-	switch s {
-	case ADA.Symbol():
-		return ADA
-	case ALGO.Symbol():
-		return ALGO
-	case ARB.Symbol():
-		return ARB
-	case AVAX.Symbol():
-		return AVAX
-	case BNB.Symbol():
-		return BNB
-	case BTC.Symbol():
-		return BTC
-	case DOGE.Symbol():
-		return DOGE
-	case ETH.Symbol():
-		return ETH
-	case FIL.Symbol():
-		return FIL
-	case FLR.Symbol():
-		return FLR
-	case LTC.Symbol():
-		return LTC
-	case MATIC.Symbol():
-		return MATIC
-	case SOL.Symbol():
-		return SOL
-	case USDC.Symbol():
-		return USDC
-	case USDT.Symbol():
-		return USDT
-	case XDC.Symbol():
-		return XDC
-	case XLM.Symbol():
-		return XLM
-	case XRP.Symbol():
-		return XRP
-	default:
-		return UnknownToken
-	}
-}
-
 // Name is used to get TokenID string value for the WS service
 func (i TokenID) Name() string {
 	return TokenIDWSNames[i]
 }
-
-// Symbol is used to get TokenID string value for the smart-contract
-func (i TokenID) Symbol() string {
-	return TokenIDSymbol[i]
-}
-
-// Index is used to get TokenID flare index
-func (i TokenID) Index() *big.Int {
-	return TokenIDIndices[i]
-}
@@ -0,0 +1,388 @@
+package flare
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"oracle-flare/pkg/flare/contracts"
+	"oracle-flare/pkg/logger"
+)
+
+const (
+	// pollRetryInterval is used to retry polling when the new price epoch is not started on-chain yet or rpc call failed
+	pollRetryInterval = time.Second
+	// submissionsPollInterval is a submit and reveal events polling interval for the http rpc provider
+	submissionsPollInterval = time.Second * 5
+	// resubscribeInterval is used to resubscribe to the ws rpc provider after the subscription error
+	resubscribeInterval = time.Second * 5
+	// blocksPollInterval is a new blocks polling interval for the http rpc provider
+	blocksPollInterval = time.Second
+)
+
+// epochWatcher is used to detect price epoch transitions and signer submit and reveal events. If the ws rpc provider is
+// given, it subscribes to the new heads and PriceSubmitter events. Polling is always running as a fallback and is the
+// only source for the http rpc provider
+type epochWatcher struct {
+	provider   *ethclient.Client
+	wsProvider *ethclient.Client
+	// contracts is used to get currently used smart-contracts set
+	contracts func() *contractSet
+	signer    common.Address
+	// fields are the chain log fields
+	fields logger.Fields
+
+	mu        sync.Mutex
+	lastEpoch *contracts.PriceEpochData
+
+	epochSubs      []chan *contracts.PriceEpochData
+	submissionSubs []chan *contracts.SubmissionEvent
+	blockSubs      []chan *big.Int
+
+	once sync.Once
+	// blocksOnce is used to start the blocks polling on the first blocks subscription
+	blocksOnce sync.Once
+	stop       chan struct{}
+	// resubscribe is used to renew the submissions subscription after the contracts set is swapped
+	resubscribe chan struct{}
+}
+
+// newEpochWatcher is used to get new epochWatcher instance. wsProvider can be nil
+func newEpochWatcher(
+	provider *ethclient.Client, wsProvider *ethclient.Client, contracts func() *contractSet, signer common.Address,
+	fields logger.Fields,
+) *epochWatcher {
+	return &epochWatcher{
+		provider:    provider,
+		wsProvider:  wsProvider,
+		contracts:   contracts,
+		signer:      signer,
+		fields:      fields,
+		stop:        make(chan struct{}),
+		resubscribe: make(chan struct{}, 1),
+	}
+}
+
+// start is used to start watching. Watching is started only once on the first subscription
+func (w *epochWatcher) start() {
+	w.once.Do(func() {
+		go w.pollEpochs()
+
+		if w.wsProvider == nil {
+			logInfo("no ws rpc provider, polling epochs and submissions", "EpochWatcher", w.fields)
+			go w.pollSubmissions()
+			return
+		}
+
+		logInfo("subscribing to the new heads and submissions", "EpochWatcher", w.fields)
+		go w.watchHeads()
+		go w.watchSubmissions()
+	})
+}
+
+// subscribeEpochs is used to get new chanel receiving price epoch data on each price epoch start
+func (w *epochWatcher) subscribeEpochs() chan *contracts.PriceEpochData {
+	ch := make(chan *contracts.PriceEpochData, 1)
+
+	w.mu.Lock()
+	w.epochSubs = append(w.epochSubs, ch)
+	w.mu.Unlock()
+
+	w.start()
+
+	return ch
+}
+
+// subscribeSubmissions is used to get new chanel receiving signer submit and reveal events
+func (w *epochWatcher) subscribeSubmissions() chan *contracts.SubmissionEvent {
+	ch := make(chan *contracts.SubmissionEvent, 10)
+
+	w.mu.Lock()
+	w.submissionSubs = append(w.submissionSubs, ch)
+	w.mu.Unlock()
+
+	w.start()
+
+	return ch
+}
+
+// subscribeBlocks is used to get new chanel receiving the new block numbers. Only the latest block is kept in the
+// chanel, so the slow subscriber skips the stale blocks
+func (w *epochWatcher) subscribeBlocks() chan *big.Int {
+	ch := make(chan *big.Int, 1)
+
+	w.mu.Lock()
+	w.blockSubs = append(w.blockSubs, ch)
+	w.mu.Unlock()
+
+	w.start()
+
+	if w.wsProvider == nil {
+		w.blocksOnce.Do(func() {
+			go w.pollBlocks()
+		})
+	}
+
+	return ch
+}
+
+// refresh is used to notify the watcher that the contracts set is swapped
+func (w *epochWatcher) refresh() {
+	select {
+	case w.resubscribe <- struct{}{}:
+	default:
+	}
+}
+
+// close is used to stop the watcher
+func (w *epochWatcher) close() {
+	close(w.stop)
+}
+
+// pollEpochs is used to poll current price epoch data. The next call is made at the end of the received epoch
+func (w *epochWatcher) pollEpochs() {
+	wait := time.Duration(0)
+
+	for {
+		select {
+		case <-w.stop:
+			return
+		case <-time.After(wait):
+		}
+
+		epoch, err := w.contracts().ftsoManager.GetCurrentPriceEpochData()
+		if err != nil {
+			logErr(fmt.Sprintln("err get epoch:", err.Error()), "EpochWatcher", w.fields)
+			wait = pollRetryInterval
+			continue
+		}
+
+		w.emitEpoch(epoch)
+
+		wait = time.Duration(epoch.EndTimestamp.Int64()-epoch.CurrentTimestamp.Int64()) * time.Second
+		if wait < pollRetryInterval {
+			wait = pollRetryInterval
+		}
+	}
+}
+
+// watchHeads is used to subscribe to the new heads and get price epoch data when the new head is out of the
+// last epoch
+func (w *epochWatcher) watchHeads() {
+	for {
+		heads := make(chan *types.Header)
+
+		sub, err := w.wsProvider.SubscribeNewHead(context.Background(), heads)
+		if err != nil {
+			logErr(fmt.Sprintln("err subscribe new heads:", err.Error()), "EpochWatcher", w.fields)
+		} else if !w.listenHeads(heads, sub.Err()) {
+			sub.Unsubscribe()
+			return
+		}
+
+		select {
+		case <-w.stop:
+			return
+		case <-time.After(resubscribeInterval):
+		}
+	}
+}
+
+// listenHeads is used to listen to the new heads subscription. Returns false if the watcher is stopped
+func (w *epochWatcher) listenHeads(heads chan *types.Header, errs <-chan error) bool {
+	for {
+		select {
+		case <-w.stop:
+			return false
+		case err := <-errs:
+			logErr(fmt.Sprintln("new heads subscription err:", err), "EpochWatcher", w.fields)
+			return true
+		case head := <-heads:
+			w.emitBlock(head.Number)
+
+			w.mu.Lock()
+			last := w.lastEpoch
+			w.mu.Unlock()
+
+			if last != nil && head.Time < last.EndTimestamp.Uint64() {
+				continue
+			}
+
+			epoch, err := w.contracts().ftsoManager.GetCurrentPriceEpochData()
+			if err != nil {
+				logErr(fmt.Sprintln("err get epoch:", err.Error()), "EpochWatcher", w.fields)
+				continue
+			}
+
+			w.emitEpoch(epoch)
+		}
+	}
+}
+
+// emitEpoch is used to send epoch data to all subscribers if it is a new epoch
+func (w *epochWatcher) emitEpoch(epoch *contracts.PriceEpochData) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.lastEpoch != nil && epoch.EpochID.Cmp(w.lastEpoch.EpochID) <= 0 {
+		return
+	}
+
+	w.lastEpoch = epoch
+	logInfo(fmt.Sprintf("new price epoch: %v", epoch.EpochID), "EpochWatcher", w.fields.With(logger.FieldEpochID, epoch.EpochID))
+
+	for _, ch := range w.epochSubs {
+		select {
+		case ch <- epoch:
+		default:
+			logWarn(fmt.Sprintf("epoch subscriber is busy, epoch %v skipped", epoch.EpochID), "EpochWatcher", w.fields.With(logger.FieldEpochID, epoch.EpochID))
+		}
+	}
+}
+
+// watchSubmissions is used to subscribe to the signer submit and reveal events
+func (w *epochWatcher) watchSubmissions() {
+	for {
+		events := make(chan *contracts.SubmissionEvent)
+
+		sub, err := w.contracts().watchSubmitter.WatchSubmissions(w.signer, events)
+		if err != nil {
+			logErr(fmt.Sprintln("err subscribe submissions:", err.Error()), "EpochWatcher", w.fields)
+		} else {
+			listening := w.listenSubmissions(events, sub.Err())
+			sub.Unsubscribe()
+
+			if !listening {
+				return
+			}
+		}
+
+		select {
+		case <-w.stop:
+			return
+		case <-time.After(resubscribeInterval):
+		}
+	}
+}
+
+// listenSubmissions is used to listen to the submissions subscription. Returns false if the watcher is stopped
+func (w *epochWatcher) listenSubmissions(events chan *contracts.SubmissionEvent, errs <-chan error) bool {
+	for {
+		select {
+		case <-w.stop:
+			return false
+		case err := <-errs:
+			logErr(fmt.Sprintln("submissions subscription err:", err), "EpochWatcher", w.fields)
+			return true
+		case <-w.resubscribe:
+			logInfo("contracts changed, resubscribing submissions", "EpochWatcher", w.fields)
+			return true
+		case e := <-events:
+			w.emitSubmission(e)
+		}
+	}
+}
+
+// pollSubmissions is used to poll the signer submit and reveal events with the http rpc provider
+func (w *epochWatcher) pollSubmissions() {
+	lastBlock := uint64(0)
+
+	for {
+		select {
+		case <-w.stop:
+			return
+		case <-time.After(submissionsPollInterval):
+		}
+
+		head, err := w.provider.BlockNumber(context.Background())
+		if err != nil {
+			logErr(fmt.Sprintln("err get block number:", err.Error()), "EpochWatcher", w.fields)
+			continue
+		}
+
+		if lastBlock == 0 || head <= lastBlock {
+			lastBlock = head
+			continue
+		}
+
+		events, err := w.contracts().watchSubmitter.FilterSubmissions(w.signer, lastBlock+1, head)
+		if err != nil {
+			logErr(fmt.Sprintln("err filter submissions:", err.Error()), "EpochWatcher", w.fields)
+			continue
+		}
+
+		for _, e := range events {
+			w.emitSubmission(e)
+		}
+
+		lastBlock = head
+	}
+}
+
+// emitSubmission is used to send submission event to all subscribers
+func (w *epochWatcher) emitSubmission(e *contracts.SubmissionEvent) {
+	fields := w.fields.With(logger.FieldEpochID, e.EpochID).With(logger.FieldTxHash, e.TxHash.Hex())
+	logInfo(fmt.Sprintf("%s confirmed for epoch %v tx: %s", e.Type, e.EpochID, e.TxHash.Hex()), "EpochWatcher", fields)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, ch := range w.submissionSubs {
+		select {
+		case ch <- e:
+		default:
+			logWarn(fmt.Sprintf("submissions subscriber is busy, %s for epoch %v skipped", e.Type, e.EpochID), "EpochWatcher", fields)
+		}
+	}
+}
+
+// pollBlocks is used to poll the new block numbers with the http rpc provider
+func (w *epochWatcher) pollBlocks() {
+	lastBlock := uint64(0)
+
+	for {
+		select {
+		case <-w.stop:
+			return
+		case <-time.After(blocksPollInterval):
+		}
+
+		head, err := w.provider.BlockNumber(context.Background())
+		if err != nil {
+			logErr(fmt.Sprintln("err get block number:", err.Error()), "EpochWatcher", w.fields)
+			continue
+		}
+
+		if head <= lastBlock {
+			continue
+		}
+
+		w.emitBlock(new(big.Int).SetUint64(head))
+		lastBlock = head
+	}
+}
+
+// emitBlock is used to send the new block number to all subscribers. The stale block is dropped if the subscriber has
+// not received it yet
+func (w *epochWatcher) emitBlock(block *big.Int) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, ch := range w.blockSubs {
+		select {
+		case <-ch:
+		default:
+		}
+
+		select {
+		case ch <- block:
+		default:
+		}
+	}
+}
@@ -1,57 +1,133 @@
 package flare
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
 	"fmt"
 	"math/big"
+	"sync"
+	"sync/atomic"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/params"
 
 	"oracle-flare/config"
 	"oracle-flare/pkg/flare/contracts"
-	"oracle-flare/pkg/flare/contracts/flareChain"
-	"oracle-flare/pkg/flare/contracts/songbirdChain"
+	"oracle-flare/pkg/flare/contracts/ftsov2"
+	"oracle-flare/pkg/logger"
 )
 
+// ErrNotSupported is returned by the IFlare methods using the smart-contracts which are not available on the chain or
+// in the protocol, e.g. the VoterWhitelister in the FTSOv2 protocol
+var ErrNotSupported = errors.New("not supported by the chain or protocol")
+
 // IFlare is a flare smart-contracts service interface. It aggregates all needed methods in one interface and is used
 // as an entrypoint for the flare service interactions
 type IFlare interface {
+	// SignerAddress is used to get the signer (data-provider) address
+	SignerAddress() common.Address
+	// ChainID is used to get the chain ID
+	ChainID() int
+	// IsTokenSupported is used to check if given token ID can be submitted. The FTSOv1 tokens need the chain FTSO
+	// index, the FTSOv2 tokens need the configured feed
+	IsTokenSupported(index contracts.TokenID) bool
+	// SetGas is used to change the gas settings of the next transactions. Zero values are estimated by the rpc provider
+	SetGas(limit uint64, priceGwei float64)
 	// RequestWhitelistingVoter is used to whitelist given address for given token ID
 	RequestWhitelistingVoter(address common.Address, index contracts.TokenID) error
 	// GetFtsoWhitelistedPriceProviders is used to get all whitelisted providers for given token ID
 	GetFtsoWhitelistedPriceProviders(index contracts.TokenID) ([]common.Address, error)
+	// MaxVotersForFtso is used to get max number of whitelisted providers for given token ID
+	MaxVotersForFtso(index contracts.TokenID) (*big.Int, error)
+	// ChilledUntilRewardEpoch is used to get the reward epoch until which given address is chilled
+	ChilledUntilRewardEpoch(address common.Address) (*big.Int, error)
+	// GetCurrentRewardEpoch is used to get current reward epoch id
+	GetCurrentRewardEpoch() (*big.Int, error)
+	// GetRewardEpochVotePowerBlock is used to get the vote power block for given reward epoch
+	GetRewardEpochVotePowerBlock(rewardEpoch *big.Int) (*big.Int, error)
+	// GetVotePowerWeights is used to get the whitelister vote power weights of the addresses for given token ID
+	GetVotePowerWeights(index contracts.TokenID, addresses []common.Address) ([]*big.Int, error)
+	// GetCurrentPrice is used to get the last finalized FTSO price for given token ID
+	GetCurrentPrice(index contracts.TokenID) (*contracts.CurrentPrice, error)
 	// GetCurrentPriceEpochData is used to get current price epoch data. New price epoch data is set each 3 minutes
 	GetCurrentPriceEpochData() (*contracts.PriceEpochData, error)
-	// CommitPrices is used to commit prices for given epoch id
-	CommitPrices(epochID *big.Int, indices []contracts.TokenID, prices []*big.Int, random *big.Int) error
+	// CommitPrices is used to commit prices for given epoch id. The ctx is the epoch trace context
+	CommitPrices(ctx context.Context, epochID *big.Int, indices []contracts.TokenID, prices []*big.Int, random *big.Int) error
 	// RevealPrices is used to reveal committed prices for given epoch id. Should be revealed before the epoch
-	// reveal end timestamp
-	RevealPrices(epochID *big.Int, indices []contracts.TokenID, prices []*big.Int, random *big.Int) error
+	// reveal end timestamp. The ctx is the epoch trace context
+	RevealPrices(ctx context.Context, epochID *big.Int, indices []contracts.TokenID, prices []*big.Int, random *big.Int) error
+	// SubscribePriceEpochs is used to get new chanel receiving price epoch data on each price epoch start
+	SubscribePriceEpochs() chan *contracts.PriceEpochData
+	// SubscribeSubmissions is used to get new chanel receiving signer hash submitted and prices revealed events
+	SubscribeSubmissions() chan *contracts.SubmissionEvent
+	// SubscribeBlocks is used to get new chanel receiving the new block numbers
+	SubscribeBlocks() chan *big.Int
+	// SetBalance is used to change the signer balance monitoring configs. The monitoring is disabled if the interval is 0
+	SetBalance(conf *config.Balance)
+	// BalanceStatus is used to get the signer balance monitoring status. Returns nil until the balance is checked or if
+	// the monitoring is disabled
+	BalanceStatus() *BalanceStatus
+	// FastUpdater is used to get the FTSOv2 FastUpdater. Returns ErrNotSupported if the fast updates are not enabled
+	FastUpdater() (contracts.IFastUpdater, error)
 	// Close is used to close the flare service
 	Close()
 }
 
 // flare is a flare-service struct implementing IFlare interface
 type flare struct {
-	conf     *config.Flare
-	provider *ethclient.Client
-	signer   *bind.TransactOpts
-
-	// used flare smart-contracts
-
-	whitLister     contracts.IVoterWhiteLister
-	priceSubmitter contracts.IPriceSubmitter
-	ftsoManager    contracts.IFTSOManager
-	ftsoRegistry   contracts.IFTSORegistry
-	register       *registerContract
+	conf *config.Flare
+	// clients are the rpc provider connections. They are closed with the flare only if ownClients is true
+	clients    *Clients
+	ownClients bool
+	provider   *ethclient.Client
+	// wsProvider is used for subscriptions. It is nil if no ws rpc url is given
+	wsProvider *ethclient.Client
+	signer     *bind.TransactOpts
+	// signingPolicyKey is the FTSOv2 signing policy private key. It signs the voting round results and the fast updates.
+	// It is nil if neither the signing phase nor the fast updates are enabled
+	signingPolicyKey *ecdsa.PrivateKey
+	// gasLimit and gasPrice are applied to each transaction. Zero and nil are estimated by the rpc provider
+	gasLimit atomic.Uint64
+	gasPrice atomic.Pointer[big.Int]
+
+	// chain is the flare chain adapter of the configured chain ID
+	chain *contracts.ChainAdapter
+	// tokens are the chain token indices and symbols. They are refilled on each reward epoch change
+	tokens   *contracts.TokenTable
+	protocol Protocol
+	// feeds are the FTSOv2 canonical feeds order. It is used only with the FTSOv2 protocol
+	feeds []*ftsov2.Feed
+	// sortitionKey is the FTSOv2 fast updates sortition key. It is nil if the fast updates are not enabled
+	sortitionKey *ftsov2.SortitionKey
+	register     *registerContract
+	// set is a currently used flare smart-contracts set. It is swapped on each reward epoch change
+	set atomic.Pointer[contractSet]
+
+	watcher *epochWatcher
+	// balance is the signer balance monitor. It is run with the disabled monitoring too and nil until the init
+	balance *balanceMonitor
+	// trackOnce is used to start reward epochs tracking on the first price epochs subscription
+	trackOnce sync.Once
+	stop      chan struct{}
 }
 
-// NewFlare is used to get new flare instance
+// NewFlare is used to get new flare instance with its own rpc provider connections
 func NewFlare(conf *config.Flare) IFlare {
+	return NewFlareWithClients(conf, nil)
+}
+
+// NewFlareWithClients is used to get new flare instance using the shared rpc provider connections. They are not
+// closed on the flare Close. Own connections are dialed if clients is nil
+func NewFlareWithClients(conf *config.Flare, clients *Clients) IFlare {
 	f := &flare{
-		conf: conf,
+		conf:    conf,
+		clients: clients,
+		tokens:  contracts.NewTokenTable(),
+		stop:    make(chan struct{}),
 	}
 
 	f.init()
@@ -61,144 +137,328 @@ func NewFlare(conf *config.Flare) IFlare {
 
 // init is used to init flare service and all its dependencies
 func (f *flare) init() {
-	logInfo("new flare pkg init...", "Init")
+	logInfo("new flare pkg init...", "Init", f.fields())
 
 	// parse chain ID
 
-	id := ChainIDFromInt(f.conf.ChainID)
+	chain, err := getChain(f.conf.ChainID)
+	if err != nil {
+		logFatal(err.Error(), "Init", f.fields())
+	}
+
+	f.chain = chain
+
+	// parse the protocol. The chain default protocol is used if it is not set
+
+	protocol, err := ConfigProtocol(f.conf)
+	if err != nil {
+		logFatal(err.Error(), "Init", f.fields())
+	}
+
+	f.protocol = protocol
+
+	if protocol == FTSOv2 {
+		if f.conf.Scaling == nil || len(f.conf.Scaling.Feeds) == 0 {
+			logFatal("no ftsov2 feeds order in the config", "Init", f.fields())
+		}
+
+		if f.feeds, err = ftsov2.ParseFeeds(f.conf.Scaling.Feeds); err != nil {
+			logFatal(fmt.Sprintln("err parse ftsov2 feeds:", err.Error()), "Init", f.fields())
+		}
+	}
+
+	if f.conf.FastUpdates != nil && f.conf.FastUpdates.Enabled {
+		if protocol != FTSOv2 {
+			logFatal("fast updates are supported only with the ftsov2 protocol", "Init", f.fields())
+		}
+
+		if len(f.conf.FastUpdates.Feeds) == 0 {
+			logFatal("no fast updates feeds in the config", "Init", f.fields())
+		}
 
-	if id == UnknownChain {
-		logFatal(fmt.Sprintf("chain id: %v not supported", f.conf.ChainID), "Init")
+		if f.sortitionKey, err = ftsov2.NewSortitionKey(f.conf.FastUpdates.SortitionKey); err != nil {
+			logFatal(fmt.Sprintln("err get sortition key:", err.Error()), "Init", f.fields())
+		}
 	}
 
+	logInfo(fmt.Sprintf("chain: %s (%v) protocol: %s", chain, chain.ID, protocol), "Init", f.fields())
+
 	// get signer
 
 	if f.conf.SignerPK == "" {
-		logFatal("no pk in the configs", "Init")
+		logFatal("no pk in the configs", "Init", f.fields())
 	}
 
 	pk, err := crypto.HexToECDSA(f.conf.SignerPK)
 	if err != nil {
-		logFatal(fmt.Sprintln("err get PK:", err.Error()), "Init")
+		logFatal(fmt.Sprintln("err get PK:", err.Error()), "Init", f.fields())
 	}
 
-	if f.signer, err = bind.NewKeyedTransactorWithChainID(pk, big.NewInt(int64(id.ID()))); err != nil {
-		logFatal(fmt.Sprintln("err get signer:", err.Error()), "Init")
+	if f.signer, err = bind.NewKeyedTransactorWithChainID(pk, big.NewInt(int64(chain.ID))); err != nil {
+		logFatal(fmt.Sprintln("err get signer:", err.Error()), "Init", f.fields())
 	}
 
-	// init rpc provider
+	// FTSOv2 entities sign the voting round results and fast updates with the registered signing policy address, not
+	// with the submit address
 
-	if f.conf.RpcURL == "" {
-		logFatal("no rpc provider url found in the config", "Init")
+	if protocol == FTSOv2 && (f.conf.Scaling.ResultURL != "" || f.sortitionKey != nil) {
+		if f.conf.SigningPolicyKey == "" {
+			logFatal("no ftsov2 signing policy key in the configs", "Init", f.fields())
+		}
+
+		if f.signingPolicyKey, err = crypto.HexToECDSA(f.conf.SigningPolicyKey); err != nil {
+			logFatal(fmt.Sprintln("err get signing policy key:", err.Error()), "Init", f.fields())
+		}
 	}
 
-	rpc, err := ethclient.Dial(f.conf.RpcURL)
-	if err != nil {
-		logFatal(fmt.Sprintf("err dial provider %s: %s", f.conf.RpcURL, err.Error()), "Init")
+	f.SetGas(f.conf.GasLimit, f.conf.GasPriceGwei)
+
+	// init rpc providers. Own clients are dialed if no shared clients are given
+
+	if f.clients == nil {
+		f.clients = NewClients(f.conf)
+		f.ownClients = true
 	}
 
-	f.provider = rpc
+	f.provider = f.clients.provider
+	f.wsProvider = f.clients.wsProvider
 
 	// init all smart-contracts. Only the registry smart-contract address is given in the config, all other
 	// smart-contract addresses are fetched from the blockchain
 
 	if f.conf.RegistryContractAddress == "" {
-		logFatal("no registry priceSubmitter found in the config", "Init")
+		logFatal("no registry priceSubmitter found in the config", "Init", f.fields())
 	}
 
 	f.register = newRegisterContract(f.provider, f.conf.RegistryContractAddress)
 
-	submitterAddress, err := f.register.getContractAddress("PriceSubmitter")
+	set, err := f.newContractSet()
 	if err != nil {
-		logFatal(fmt.Sprintln("get submitter address error:", err.Error()), "Init")
+		logFatal(fmt.Sprintln("get contracts error:", err.Error()), "Init", f.fields())
 	}
 
-	managerAddress, err := f.register.getContractAddress("FtsoManager")
-	if err != nil {
-		logFatal(fmt.Sprintln("get ftsoManager address error:", err.Error()), "Init")
+	if err := f.fillTokenIDs(set); err != nil {
+		logFatal(fmt.Sprintln("fill token ids error:", err.Error()), "Init", f.fields())
 	}
 
-	registryAddress, err := f.register.getContractAddress("FtsoRegistry")
-	if err != nil {
-		logFatal(fmt.Sprintln("get ftsoRegistry address error:", err.Error()), "Init")
+	f.set.Store(set)
+
+	// watcher is started on the first subscription
+
+	f.watcher = newEpochWatcher(f.provider, f.wsProvider, f.getContracts, f.signer.From, f.fields())
+
+	// signer balance monitoring
+
+	// the monitor is run with the disabled monitoring too, so it can be enabled on the config reload
+	prefix, native := symbolRules(f.conf, f.chain)
+	if native == "" {
+		native = prefix + contracts.FLR.Name()
+	}
+
+	f.balance = newBalanceMonitor(f, f.conf.Balance, native)
+	go f.balance.run()
+}
+
+// getContracts is used to get currently used flare smart-contracts set
+func (f *flare) getContracts() *contractSet {
+	return f.set.Load()
+}
+
+// fillTokenIDs is used to fill token ids with the onchain data and token symbols string values
+func (f *flare) fillTokenIDs(set *contractSet) error {
+	// there are no FTSO indices in the FTSOv2 protocol, tokens are mapped to the feeds
+	if set.ftsoRegistry == nil {
+		return nil
 	}
 
-	voterAddress, err := f.register.getContractAddress("VoterWhitelister")
+	data, err := set.ftsoRegistry.GetSupportedIndicesAndSymbols()
 	if err != nil {
-		logFatal(fmt.Sprintln("get voterWhitelister address error:", err.Error()), "Init")
+		return err
 	}
 
-	// For different chain IDs different smart contracts (addresses and ABIs) are used.
-	// Each smart contract implements the contracts.IContracts interfaces
+	prefix, native := symbolRules(f.conf, f.chain)
+	f.tokens.Fill(data, prefix, native)
 
-	switch id {
-	case FlareChain:
-		f.priceSubmitter = flareChain.NewPriceSubmitter(f.provider, *submitterAddress, f.signer)
-		f.ftsoManager = flareChain.NewFTSOManager(f.provider, *managerAddress)
-		f.ftsoRegistry = flareChain.NewFTSORegistry(f.provider, *registryAddress)
-		f.whitLister = flareChain.NewVoterWhiteLister(f.provider, *voterAddress, f.signer)
+	return nil
+}
 
-		if err := f.fillTokenIDs(); err != nil {
-			logFatal(fmt.Sprintln("fill token ids error:", err.Error()), "Init")
-		}
+func (f *flare) CommitPrices(ctx context.Context, epochID *big.Int, indices []contracts.TokenID, prices []*big.Int, random *big.Int) error {
+	// the reveals are not paused, so the committed prices are never left unrevealed
+	if f.balance != nil && f.balance.paused.Load() {
+		return ErrPaused
+	}
+
+	return f.getContracts().priceSubmitter.CommitPrices(ctx, epochID, indices, prices, random)
+}
 
-		// Same ABI as for Flare main-net for methods that are used in this service
-	case Coston2Chain:
-		f.priceSubmitter = flareChain.NewPriceSubmitter(f.provider, *submitterAddress, f.signer)
-		f.ftsoManager = flareChain.NewFTSOManager(f.provider, *managerAddress)
-		f.ftsoRegistry = flareChain.NewFTSORegistry(f.provider, *registryAddress)
-		f.whitLister = flareChain.NewVoterWhiteLister(f.provider, *voterAddress, f.signer)
+func (f *flare) RevealPrices(ctx context.Context, epochID *big.Int, indices []contracts.TokenID, prices []*big.Int, random *big.Int) error {
+	return f.getContracts().priceSubmitter.RevealPrices(ctx, epochID, indices, prices, random)
+}
 
-		if err := f.fillTokenIDs(); err != nil {
-			logFatal(fmt.Sprintln("fill token ids error:", err.Error()), "Init")
-		}
+func (f *flare) GetCurrentPriceEpochData() (*contracts.PriceEpochData, error) {
+	return f.getContracts().ftsoManager.GetCurrentPriceEpochData()
+}
 
-	case SongBirdChain:
-		f.priceSubmitter = songbirdChain.NewPriceSubmitter(f.provider, *submitterAddress)
-		f.ftsoManager = songbirdChain.NewFTSOManager(f.provider, *managerAddress)
-		f.ftsoRegistry = songbirdChain.NewFTSORegistry(f.provider, *registryAddress)
+func (f *flare) SignerAddress() common.Address {
+	return f.signer.From
+}
 
-		if err := f.fillTokenIDs(); err != nil {
-			logFatal(fmt.Sprintln("fill token ids error:", err.Error()), "Init")
+func (f *flare) ChainID() int {
+	return f.conf.ChainID
+}
+
+func (f *flare) IsTokenSupported(index contracts.TokenID) bool {
+	// there are no FTSO indices in the FTSOv2 protocol, the values are encoded by the feeds
+	if f.protocol == FTSOv2 {
+		for _, feed := range f.feeds {
+			if feed.Token == index {
+				return true
+			}
 		}
+
+		return false
 	}
+
+	return f.tokens.Index(index).Sign() >= 0
 }
 
-// fillTokenIDs is used to fill token ids with the onchain data and token symbols string values
-func (f *flare) fillTokenIDs() error {
-	data, err := f.ftsoRegistry.GetSupportedIndicesAndSymbols()
-	if err != nil {
-		return err
-	}
+func (f *flare) SetGas(limit uint64, priceGwei float64) {
+	f.gasLimit.Store(limit)
 
-	contracts.FillTokenIDAndNames(data, f.conf.ChainID == Coston2Chain.ID())
+	var price *big.Int
+	if priceGwei > 0 {
+		price, _ = new(big.Float).Mul(big.NewFloat(priceGwei), big.NewFloat(params.GWei)).Int(nil)
+	}
+	f.gasPrice.Store(price)
 
-	return nil
+	logInfo(fmt.Sprintf("gas limit: %v gas price: %v gwei (0 is estimated)", limit, priceGwei), "SetGas", f.fields())
 }
 
-func (f *flare) CommitPrices(epochID *big.Int, indices []contracts.TokenID, prices []*big.Int, random *big.Int) error {
-	return f.priceSubmitter.CommitPrices(epochID, indices, prices, random)
+// fields is used to get the log fields of the flare chain
+func (f *flare) fields() logger.Fields {
+	return logger.Fields{logger.FieldChainID: f.conf.ChainID}
 }
 
-func (f *flare) RevealPrices(epochID *big.Int, indices []contracts.TokenID, prices []*big.Int, random *big.Int) error {
-	return f.priceSubmitter.RevealPrices(epochID, indices, prices, random)
+// transactOpts is used to get the copy of the signer transaction options with the current gas settings
+func (f *flare) transactOpts() *bind.TransactOpts {
+	opts := *f.signer
+	opts.GasLimit = f.gasLimit.Load()
+	opts.GasPrice = f.gasPrice.Load()
+
+	return &opts
 }
 
-func (f *flare) GetCurrentPriceEpochData() (*contracts.PriceEpochData, error) {
-	return f.ftsoManager.GetCurrentPriceEpochData()
+func (f *flare) GetCurrentPrice(index contracts.TokenID) (*contracts.CurrentPrice, error) {
+	set := f.getContracts()
+	if set.ftsoRegistry == nil {
+		return nil, ErrNotSupported
+	}
+
+	return set.ftsoRegistry.GetCurrentPriceWithDecimals(index)
 }
 
 func (f *flare) RequestWhitelistingVoter(address common.Address, index contracts.TokenID) error {
-	return f.whitLister.RequestWhitelistingVoter(address, index)
+	set := f.getContracts()
+	if set.whitLister == nil {
+		return ErrNotSupported
+	}
+
+	return set.whitLister.RequestWhitelistingVoter(address, index)
 }
 
 func (f *flare) GetFtsoWhitelistedPriceProviders(index contracts.TokenID) ([]common.Address, error) {
-	return f.whitLister.GetFtsoWhitelistedPriceProviders(index)
+	set := f.getContracts()
+	if set.whitLister == nil {
+		return nil, ErrNotSupported
+	}
+
+	return set.whitLister.GetFtsoWhitelistedPriceProviders(index)
+}
+
+func (f *flare) MaxVotersForFtso(index contracts.TokenID) (*big.Int, error) {
+	set := f.getContracts()
+	if set.whitLister == nil {
+		return nil, ErrNotSupported
+	}
+
+	return set.whitLister.MaxVotersForFtso(index)
+}
+
+func (f *flare) ChilledUntilRewardEpoch(address common.Address) (*big.Int, error) {
+	set := f.getContracts()
+	if set.whitLister == nil {
+		return nil, ErrNotSupported
+	}
+
+	return set.whitLister.ChilledUntilRewardEpoch(address)
+}
+
+func (f *flare) GetVotePowerWeights(index contracts.TokenID, addresses []common.Address) ([]*big.Int, error) {
+	set := f.getContracts()
+	if set.whitLister == nil {
+		return nil, ErrNotSupported
+	}
+
+	return set.whitLister.GetVotePowerWeights(index, addresses)
+}
+
+func (f *flare) GetCurrentRewardEpoch() (*big.Int, error) {
+	return f.getContracts().ftsoManager.GetCurrentRewardEpoch()
+}
+
+func (f *flare) GetRewardEpochVotePowerBlock(rewardEpoch *big.Int) (*big.Int, error) {
+	return f.getContracts().ftsoManager.GetRewardEpochVotePowerBlock(rewardEpoch)
+}
+
+func (f *flare) SubscribePriceEpochs() chan *contracts.PriceEpochData {
+	f.trackOnce.Do(func() {
+		go f.trackRewardEpochs(f.watcher.subscribeEpochs())
+	})
+
+	return f.watcher.subscribeEpochs()
+}
+
+func (f *flare) SubscribeSubmissions() chan *contracts.SubmissionEvent {
+	return f.watcher.subscribeSubmissions()
+}
+
+func (f *flare) SubscribeBlocks() chan *big.Int {
+	return f.watcher.subscribeBlocks()
+}
+
+func (f *flare) SetBalance(conf *config.Balance) {
+	if f.balance == nil {
+		return
+	}
+
+	f.balance.setConfig(conf)
+}
+
+func (f *flare) BalanceStatus() *BalanceStatus {
+	if f.balance == nil {
+		return nil
+	}
+
+	return f.balance.status.Load()
+}
+
+func (f *flare) FastUpdater() (contracts.IFastUpdater, error) {
+	set := f.getContracts()
+	if set.fastUpdater == nil {
+		return nil, ErrNotSupported
+	}
+
+	return set.fastUpdater, nil
 }
 
 func (f *flare) Close() {
-	logInfo("close rpc provider connection...", "Close")
-	if f.provider != nil {
-		f.provider.Close()
+	close(f.stop)
+
+	if f.watcher != nil {
+		f.watcher.close()
+	}
+
+	if f.ownClients {
+		f.clients.Close()
 	}
 }
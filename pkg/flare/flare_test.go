@@ -0,0 +1,50 @@
+package flare
+
+import (
+	"math/big"
+	"testing"
+
+	"oracle-flare/pkg/flare/contracts"
+	"oracle-flare/pkg/flare/contracts/ftsov2"
+)
+
+func TestIsTokenSupported(t *testing.T) {
+	feeds, err := ftsov2.ParseFeeds([]string{"BTC/USD=2", "ETH/USD=3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ftsov1Tokens := contracts.NewTokenTable()
+	ftsov1Tokens.Fill(&contracts.IndicesAndSymbols{
+		Indices: []*big.Int{big.NewInt(2)},
+		Symbols: []string{contracts.BTC.Name()},
+	}, "", "")
+
+	tests := []struct {
+		name  string
+		flare *flare
+		want  map[contracts.TokenID]bool
+	}{
+		{
+			name:  "ftsov1",
+			flare: &flare{protocol: FTSOv1, tokens: ftsov1Tokens},
+			want:  map[contracts.TokenID]bool{contracts.BTC: true, contracts.ETH: false},
+		},
+		{
+			// the FTSOv2 token table is never filled, all indices are -1
+			name:  "ftsov2",
+			flare: &flare{protocol: FTSOv2, tokens: contracts.NewTokenTable(), feeds: feeds},
+			want:  map[contracts.TokenID]bool{contracts.BTC: true, contracts.ETH: true, contracts.XRP: false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for token, want := range tt.want {
+				if got := tt.flare.IsTokenSupported(token); got != want {
+					t.Errorf("%s supported: got %v, want %v", token.Name(), got, want)
+				}
+			}
+		})
+	}
+}
@@ -2,21 +2,30 @@ package flare
 
 import (
 	"fmt"
+	"oracle-flare/pkg/alerts"
 	"oracle-flare/pkg/logger"
 )
 
-func logFatal(msg string, method string) {
-	logger.Log().WithField("layer", fmt.Sprintf("Flare-%s", method)).Fatal(msg)
+func logFatal(msg string, method string, fields ...logger.Fields) {
+	logger.WithLayer(fmt.Sprintf("Flare-%s", method), fields...).Fatal(msg)
 }
 
-//func logWarn(msg string, method string) {
-//	logger.Log().WithField("layer", fmt.Sprintf("Flare-%s", method)).Warning(msg)
-//}
+func logWarn(msg string, method string, fields ...logger.Fields) {
+	logger.WithLayer(fmt.Sprintf("Flare-%s", method), fields...).Warning(msg)
+}
+
+// logAlert is used to log the events which need the operator attention and send them to the alert webhooks. Key is
+// the alert subject, e.g. the token name, the alerts with the same event, layer and key are deduplicated
+func logAlert(event alerts.Event, key string, msg string, method string, fields ...logger.Fields) {
+	layer := fmt.Sprintf("Flare-%s", method)
+	logger.WithLayer(layer, fields...).WithField("alert", event.String()).Error(msg)
+	alerts.Notify(event, layer, key, msg)
+}
 
-func logInfo(msg string, method string) {
-	logger.Log().WithField("layer", fmt.Sprintf("Flare-%s", method)).Info(msg)
+func logInfo(msg string, method string, fields ...logger.Fields) {
+	logger.WithLayer(fmt.Sprintf("Flare-%s", method), fields...).Info(msg)
 }
 
-//func logErr(msg string, method string) {
-//	logger.Log().WithField("layer", fmt.Sprintf("Flare-%s", method)).Error(msg)
-//}
+func logErr(msg string, method string, fields ...logger.Fields) {
+	logger.WithLayer(fmt.Sprintf("Flare-%s", method), fields...).Error(msg)
+}
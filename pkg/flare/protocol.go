@@ -0,0 +1,37 @@
+package flare
+
+import "fmt"
+
+// Protocol is a price submission protocol type
+type Protocol int
+
+const (
+	UnknownProtocol Protocol = iota
+	// FTSOv1 is the PriceSubmitter commit and reveal protocol
+	FTSOv1
+	// FTSOv2 is the Flare Systems Protocol FTSOv2 Scaling: commit, reveal and sign rounds via the Submission contract
+	FTSOv2
+)
+
+var ProtocolStrings = [...]string{
+	UnknownProtocol: "UnknownProtocol",
+	FTSOv1:          "ftsov1",
+	FTSOv2:          "ftsov2",
+}
+
+// ProtocolFromString is used to get the protocol from the given string
+func ProtocolFromString(s string) (Protocol, error) {
+	switch s {
+	case FTSOv1.String():
+		return FTSOv1, nil
+	case FTSOv2.String():
+		return FTSOv2, nil
+	default:
+		return UnknownProtocol, fmt.Errorf("protocol %s not supported", s)
+	}
+}
+
+// String is used to get Protocol string value
+func (p Protocol) String() string {
+	return ProtocolStrings[p]
+}
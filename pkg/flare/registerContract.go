@@ -2,20 +2,18 @@ package flare
 
 import (
 	"fmt"
-	"github.com/ethereum/go-ethereum/accounts/abi"
+
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 
 	common_abi "oracle-flare/abis"
-	"oracle-flare/utils/contractUtils"
 )
 
 // registerContract is a register smart-contract. It has the same ABI and address for all flare chains
 type registerContract struct {
 	address  common.Address
-	abi      *abi.ABI
-	contract *bind.BoundContract
+	contract *common_abi.FlareContractRegistry
 	provider *ethclient.Client
 }
 
@@ -31,26 +29,33 @@ func newRegisterContract(provider *ethclient.Client, address string) *registerCo
 	return c
 }
 
-// getContractAddress is used to get contract address by given contract name
-func (c *registerContract) getContractAddress(name string) (*common.Address, error) {
-	out := []interface{}{}
-
-	if err := c.contract.Call(&bind.CallOpts{}, &out, "getContractAddressByName", name); err != nil {
+// getAllContracts is used to get all registered contract addresses mapped by the contract names
+func (c *registerContract) getAllContracts() (map[string]common.Address, error) {
+	out, err := c.contract.GetAllContracts(&bind.CallOpts{})
+	if err != nil {
 		return nil, err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	names, addresses := out.Names, out.Addresses
+
+	if len(names) != len(addresses) {
+		return nil, fmt.Errorf("got %v names and %v addresses", len(names), len(addresses))
+	}
+
+	res := make(map[string]common.Address, len(names))
+	for i, n := range names {
+		res[n] = addresses[i]
+	}
 
-	return &out0, nil
+	return res, nil
 }
 
 // init is used to init the registerContract
 func (c *registerContract) init() {
-	abiI, contract, err := contractUtils.GetContract(common_abi.IFlareContractRegistry, c.address, c.provider, c.provider)
+	contract, err := common_abi.NewFlareContractRegistry(c.address, c.provider)
 	if err != nil {
 		logFatal(fmt.Sprintln("err get contract register:", err.Error()), "Init")
 	}
 
-	c.abi = abiI
 	c.contract = contract
 }
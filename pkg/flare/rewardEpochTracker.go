@@ -0,0 +1,83 @@
+package flare
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"oracle-flare/pkg/flare/contracts"
+)
+
+// trackRewardEpochs is used to refresh the contracts set and supported tokens on each reward epoch change. Refresh is
+// applied between price epochs: after the previous price epoch reveal end and before the current price epoch commit,
+// so the commit and reveal of the one price epoch always use the same contracts and indices
+func (f *flare) trackRewardEpochs(epochs chan *contracts.PriceEpochData) {
+	var rewardEpoch *big.Int
+
+	for {
+		select {
+		case <-f.stop:
+			return
+		case epoch := <-epochs:
+			current, err := f.getContracts().ftsoManager.GetCurrentRewardEpoch()
+			if err != nil {
+				logErr(fmt.Sprintln("err get current reward epoch:", err.Error()), "RewardEpochs", f.fields())
+				continue
+			}
+
+			if rewardEpoch == nil {
+				rewardEpoch = current
+				logInfo(fmt.Sprintf("current reward epoch: %v", rewardEpoch), "RewardEpochs", f.fields())
+				continue
+			}
+
+			if current.Cmp(rewardEpoch) == 0 {
+				continue
+			}
+
+			logInfo(fmt.Sprintf("reward epoch changed from %v to %v", rewardEpoch, current), "RewardEpochs", f.fields())
+
+			// the previous price epoch reveal ends after the reveal period since the current price epoch start
+			revealPeriod := epoch.RevealEndTimestamp.Int64() - epoch.EndTimestamp.Int64()
+			wait := time.Duration(epoch.StartTimestamp.Int64()+revealPeriod-epoch.CurrentTimestamp.Int64()) * time.Second
+
+			select {
+			case <-f.stop:
+				return
+			case <-time.After(wait):
+			}
+
+			// the reward epoch is not updated on failure, so refresh is retried on the next price epoch
+			if err := f.refresh(); err != nil {
+				logErr(fmt.Sprintln("err refresh contracts:", err.Error()), "RewardEpochs", f.fields())
+				continue
+			}
+
+			rewardEpoch = current
+		}
+	}
+}
+
+// refresh is used to resolve the contracts set and supported tokens again and swap them. The current set is kept if
+// any contract of the new set can not be created
+func (f *flare) refresh() error {
+	set, err := f.newContractSet()
+	if err != nil {
+		return err
+	}
+
+	if err := f.fillTokenIDs(set); err != nil {
+		return fmt.Errorf("fill token ids: %w", err)
+	}
+
+	if changed := f.getContracts().changed(set); len(changed) > 0 {
+		logInfo(fmt.Sprintln("contract addresses changed:", changed), "RewardEpochs", f.fields())
+	}
+
+	f.set.Store(set)
+	f.watcher.refresh()
+
+	logInfo("contracts and tokens refreshed", "RewardEpochs", f.fields())
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package backoff
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Backoff is an exponential backoff with jitter used for the reconnect attempts
+type Backoff struct {
+	min     time.Duration
+	max     time.Duration
+	attempt int
+}
+
+// New is used to get new Backoff instance
+func New(min time.Duration, max time.Duration) *Backoff {
+	return &Backoff{
+		min: min,
+		max: max,
+	}
+}
+
+// Next is used to get the delay before the next attempt. The delay is doubled on each attempt up to the max value,
+// then a random jitter in the [delay/2, delay) range is applied
+func (b *Backoff) Next() time.Duration {
+	d := b.max
+	if shifted := b.min << b.attempt; b.attempt < 32 && shifted > 0 && shifted < b.max {
+		d = shifted
+	}
+
+	b.attempt++
+
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// Reset is used to reset the attempts counter after the successful attempt
+func (b *Backoff) Reset() {
+	b.attempt = 0
+}
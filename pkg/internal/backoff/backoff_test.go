@@ -0,0 +1,23 @@
+package backoff
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNext(t *testing.T) {
+	b := New(time.Second, time.Second*10)
+
+	for i, want := range []time.Duration{time.Second, time.Second * 2, time.Second * 4, time.Second * 8, time.Second * 10, time.Second * 10} {
+		d := b.Next()
+		if d < want/2 || d > want {
+			t.Errorf("attempt %v: delay %v is not in [%v, %v]", i, d, want/2, want)
+		}
+	}
+
+	b.Reset()
+
+	if d := b.Next(); d > time.Second {
+		t.Errorf("delay after reset: got %v, want at most %v", d, time.Second)
+	}
+}
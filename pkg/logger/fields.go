@@ -0,0 +1,76 @@
+package logger
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	easy "github.com/t-tomalak/logrus-easy-formatter"
+)
+
+// correlation field keys. They are attached to the logs of one price epoch, token, transaction or ws subscription, so
+// the log pipeline can query them end to end across the layers
+const (
+	FieldEpochID          = "epoch_id"
+	FieldToken            = "token"
+	FieldTxHash           = "tx_hash"
+	FieldNonce            = "nonce"
+	FieldWSSubscriptionID = "ws_subscription_id"
+	FieldChainID          = "chain_id"
+)
+
+// Fields are the log correlation fields
+type Fields map[string]interface{}
+
+// textFormatter is the app text format with the fields appended as sorted key=value pairs. The layer is a part of the
+// format and is not appended
+type textFormatter struct {
+	easy *easy.Formatter
+}
+
+func (f *textFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	out, err := f.easy.Format(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(entry.Data))
+	for k := range entry.Data {
+		if k != "layer" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	buf := bytes.NewBuffer(out)
+	for _, k := range keys {
+		fmt.Fprintf(buf, " %s=%v", k, entry.Data[k])
+	}
+	buf.WriteByte('\n')
+
+	return buf.Bytes(), nil
+}
+
+// trimFormatter is used to trim the trailing new line of the messages formatted by fmt.Sprintln
+type trimFormatter struct {
+	logrus.Formatter
+}
+
+func (f *trimFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	entry.Message = strings.TrimSuffix(entry.Message, "\n")
+
+	return f.Formatter.Format(entry)
+}
+
+// With is used to get the copy of the fields with the given field added
+func (f Fields) With(key string, value interface{}) Fields {
+	fields := make(Fields, len(f)+1)
+	for k, v := range f {
+		fields[k] = v
+	}
+	fields[key] = value
+
+	return fields
+}
@@ -1,10 +1,20 @@
 package logger
 
 import (
-	"github.com/sirupsen/logrus"
-	easy "github.com/t-tomalak/logrus-easy-formatter"
+	"fmt"
 	"os"
 	"sync"
+
+	"github.com/sirupsen/logrus"
+	easy "github.com/t-tomalak/logrus-easy-formatter"
+)
+
+// log output formats
+const (
+	// FormatText is the human readable text format, the correlation fields are appended as key=value pairs
+	FormatText = "text"
+	// FormatJSON is the one JSON object per line format for the log pipelines
+	FormatJSON = "json"
 )
 
 type logger struct {
@@ -21,13 +31,72 @@ func Log() *logger {
 		log.SetLevel(logrus.InfoLevel)
 
 		log.SetOutput(os.Stdout)
-		log.SetFormatter(&easy.Formatter{
-			TimestampFormat: "2006-01-02 15:04",
-			LogFormat:       "[%lvl%]: %time% - OracleFlare-%layer%: %msg%\n",
-		})
+		log.SetFormatter(newTextFormatter())
 
 		instance = &logger{log}
 	})
 
 	return instance
 }
+
+// WithLayer is used to get the log entry of the layer with the given correlation fields
+func WithLayer(layer string, fields ...Fields) *logrus.Entry {
+	entry := Log().WithField("layer", layer)
+	for _, f := range fields {
+		entry = entry.WithFields(logrus.Fields(f))
+	}
+
+	return entry
+}
+
+// ParseLevel is used to get the logrus level from its name, e.g. "debug", "info", "warning" or "error"
+func ParseLevel(level string) (logrus.Level, error) {
+	return logrus.ParseLevel(level)
+}
+
+// SetLevel is used to change the min logged level, e.g. "debug", "info", "warning" or "error"
+func SetLevel(level string) error {
+	lvl, err := ParseLevel(level)
+	if err != nil {
+		return err
+	}
+
+	Log().SetLevel(lvl)
+
+	return nil
+}
+
+// ParseFormat is used to get the log formatter by the format name: "text" or "json"
+func ParseFormat(format string) (logrus.Formatter, error) {
+	switch format {
+	case FormatText:
+		return newTextFormatter(), nil
+	case FormatJSON:
+		return &trimFormatter{&logrus.JSONFormatter{
+			TimestampFormat: "2006-01-02T15:04:05.000Z07:00",
+			FieldMap:        logrus.FieldMap{logrus.FieldKeyMsg: "message"},
+		}}, nil
+	default:
+		return nil, fmt.Errorf("log format %s not supported", format)
+	}
+}
+
+// SetFormat is used to change the log output format: "text" or "json"
+func SetFormat(format string) error {
+	f, err := ParseFormat(format)
+	if err != nil {
+		return err
+	}
+
+	Log().SetFormatter(f)
+
+	return nil
+}
+
+// newTextFormatter is used to get the text formatter of the app log format
+func newTextFormatter() logrus.Formatter {
+	return &trimFormatter{&textFormatter{easy: &easy.Formatter{
+		TimestampFormat: "2006-01-02 15:04",
+		LogFormat:       "[%lvl%]: %time% - OracleFlare-%layer%: %msg%",
+	}}}
+}
@@ -0,0 +1,117 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"oracle-flare/config"
+)
+
+// shutdownTimeout is a max time to finish the metrics requests on shutdown
+const shutdownTimeout = time.Second * 5
+
+// namespace is the metrics names prefix
+const namespace = "oracle_flare"
+
+// registry is the oracle metrics registry. The default registry is not used, so only the oracle metrics are served
+var registry = prometheus.NewRegistry()
+
+var (
+	signerBalance = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "signer_balance",
+		Help:      "Signer balance in the native token units.",
+	}, []string{"chain_id", "signer"})
+	remainingEpochs = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "signer_remaining_epochs",
+		Help:      "Estimated number of the price epochs the signer balance is enough for. -1 if the cost is unknown.",
+	}, []string{"chain_id", "signer"})
+	commitsPaused = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "commits_paused",
+		Help:      "1 while the commits are paused because of the low signer balance, 0 otherwise.",
+	}, []string{"chain_id", "signer"})
+)
+
+func init() {
+	registry.MustRegister(signerBalance, remainingEpochs, commitsPaused)
+}
+
+// Serve is used to serve the metrics on the configured address in the Prometheus format. Nothing is served if the
+// address is empty. Returns the function stopping the server
+func Serve(conf *config.Metrics) (func(), error) {
+	if conf.Addr == "" {
+		return func() {}, nil
+	}
+
+	l, err := net.Listen("tcp", conf.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("listen %s: %w", conf.Addr, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: shutdownTimeout}
+
+	go func() {
+		if err := srv.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logWarn(fmt.Sprintln("err serve metrics:", err.Error()), "Serve")
+		}
+	}()
+
+	logInfo(fmt.Sprintf("metrics are served on %s/metrics", l.Addr()), "Serve")
+
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		if err := srv.Shutdown(ctx); err != nil {
+			logWarn(fmt.Sprintln("err stop metrics server:", err.Error()), "Shutdown")
+		}
+	}, nil
+}
+
+// CheckConfig is used to validate the metrics config: the server address
+func CheckConfig(conf *config.Metrics) error {
+	if conf.Addr == "" {
+		return nil
+	}
+
+	if _, _, err := net.SplitHostPort(conf.Addr); err != nil {
+		return fmt.Errorf("invalid metrics address %s: %w", conf.Addr, err)
+	}
+
+	return nil
+}
+
+// SetBalance is used to set the signer balance metrics of the chain. The balance is in the native token units
+func SetBalance(chainID int, signer string, balance float64, remaining int64, paused bool) {
+	labels := prometheus.Labels{"chain_id": strconv.Itoa(chainID), "signer": signer}
+
+	signerBalance.With(labels).Set(balance)
+	remainingEpochs.With(labels).Set(float64(remaining))
+
+	if paused {
+		commitsPaused.With(labels).Set(1)
+	} else {
+		commitsPaused.With(labels).Set(0)
+	}
+}
+
+// DeleteBalance is used to remove the signer balance metrics of the chain, e.g. when the monitoring is disabled
+func DeleteBalance(chainID int, signer string) {
+	labels := prometheus.Labels{"chain_id": strconv.Itoa(chainID), "signer": signer}
+
+	signerBalance.Delete(labels)
+	remainingEpochs.Delete(labels)
+	commitsPaused.Delete(labels)
+}
@@ -0,0 +1,213 @@
+package restClient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"oracle-flare/config"
+	"oracle-flare/pkg/wsClient"
+)
+
+const (
+	// minPollInterval is a min prices poll interval
+	minPollInterval = time.Second
+	// requestTimeout is a max time of the single price request
+	requestTimeout = time.Second * 10
+)
+
+// IRestClient is a rest client pkg interface
+type IRestClient interface {
+	// SubscribeCoinAveragePrice is used to start polling prices of the coins. Prices are sent to the v chanel in the
+	// same shape as the ws coin_average_price stream until Unsubscribe or Close is called
+	SubscribeCoinAveragePrice(coins []string, id int, v chan *wsClient.CoinAveragePriceStream) error
+	// Unsubscribe is used to stop polling with given id
+	Unsubscribe(id int) error
+	// UpdateConfig is used to change the url, value path, quote and poll interval of the running polls
+	UpdateConfig(conf *config.REST)
+	// Close is used to close the service
+	Close()
+}
+
+// client is a rest client pkg struct implementing IRestClient interface
+type client struct {
+	// conf is the current config. It is read on each poll
+	conf atomic.Pointer[config.REST]
+	http *http.Client
+
+	// mu guards the polls
+	mu sync.Mutex
+	// polls mapping subscription id to the poll stop chanel
+	polls map[int]chan struct{}
+
+	stop chan struct{}
+}
+
+// NewClient is used to get new client instance
+func NewClient(conf *config.REST) IRestClient {
+	c := &client{
+		http:  &http.Client{Timeout: requestTimeout},
+		polls: make(map[int]chan struct{}),
+		stop:  make(chan struct{}),
+	}
+
+	c.conf.Store(conf)
+
+	return c
+}
+
+func (c *client) SubscribeCoinAveragePrice(coins []string, id int, v chan *wsClient.CoinAveragePriceStream) error {
+	conf := c.conf.Load()
+
+	if conf.URL == "" {
+		return fmt.Errorf("rest price source url is not set")
+	}
+
+	if conf.ValuePath == "" {
+		return fmt.Errorf("rest price source value path is not set")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	select {
+	case <-c.stop:
+		return fmt.Errorf("client is closed")
+	default:
+	}
+
+	if _, ok := c.polls[id]; ok {
+		return fmt.Errorf("subscription with id %v already exists", id)
+	}
+
+	stop := make(chan struct{})
+	c.polls[id] = stop
+
+	logInfo(fmt.Sprintf("polling coins %v each %v", coins, c.interval()), "Subscribe")
+	go c.poll(coins, v, stop)
+
+	return nil
+}
+
+func (c *client) Unsubscribe(id int) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stop, ok := c.polls[id]
+	if !ok {
+		return fmt.Errorf("no subscription with id %v", id)
+	}
+
+	close(stop)
+	delete(c.polls, id)
+
+	return nil
+}
+
+func (c *client) UpdateConfig(conf *config.REST) {
+	logInfo(fmt.Sprintf("url: %s value path: %s quote: %s poll interval: %vms", conf.URL, conf.ValuePath, conf.Quote, conf.PollIntervalMS), "UpdateConfig")
+	c.conf.Store(conf)
+}
+
+func (c *client) Close() {
+	logInfo("closing rest client...", "Close")
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	select {
+	case <-c.stop:
+		return
+	default:
+		close(c.stop)
+	}
+
+	for id, stop := range c.polls {
+		close(stop)
+		delete(c.polls, id)
+	}
+}
+
+// interval is used to get the poll interval from the config
+func (c *client) interval() time.Duration {
+	interval := time.Duration(c.conf.Load().PollIntervalMS) * time.Millisecond
+	if interval < minPollInterval {
+		return minPollInterval
+	}
+
+	return interval
+}
+
+// poll is used to request prices of all coins immediately and then on each poll interval until stop is closed. The
+// config is read on each poll, so its changes are applied with the next one
+func (c *client) poll(coins []string, v chan *wsClient.CoinAveragePriceStream, stop chan struct{}) {
+	interval := c.interval()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		conf := c.conf.Load()
+
+		for _, coin := range coins {
+			value, err := c.fetch(conf, coin)
+			if err != nil {
+				logWarn(fmt.Sprintf("err fetch %s price: %s", coin, err.Error()), "Poll")
+				continue
+			}
+
+			select {
+			case <-stop:
+				return
+			case v <- &wsClient.CoinAveragePriceStream{Coin: coin, Timestamp: int(time.Now().Unix()), Value: value, Quote: conf.Quote}:
+			}
+		}
+
+		if i := c.interval(); i != interval {
+			interval = i
+			ticker.Reset(interval)
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// fetch is used to request the coin price and get the value by the configured path
+func (c *client) fetch(conf *config.REST, coin string) (float64, error) {
+	url := strings.NewReplacer("{coin}", coin, "{coin_lower}", strings.ToLower(coin)).Replace(conf.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+
+	var data interface{}
+	dec := json.NewDecoder(resp.Body)
+	dec.UseNumber()
+	if err := dec.Decode(&data); err != nil {
+		return 0, fmt.Errorf("decode response: %w", err)
+	}
+
+	return valueByPath(data, conf.ValuePath)
+}
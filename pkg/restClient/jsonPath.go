@@ -0,0 +1,42 @@
+package restClient
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// valueByPath is used to get the float value from the decoded JSON by the dot separated path. Numeric path parts are
+// used as array indices. The value can be a JSON number or a numeric string
+func valueByPath(data interface{}, path string) (float64, error) {
+	cur := data
+
+	for _, part := range strings.Split(path, ".") {
+		switch node := cur.(type) {
+		case map[string]interface{}:
+			v, ok := node[part]
+			if !ok {
+				return 0, fmt.Errorf("no %s key in the path %s", part, path)
+			}
+			cur = v
+		case []interface{}:
+			i, err := strconv.Atoi(part)
+			if err != nil || i < 0 || i >= len(node) {
+				return 0, fmt.Errorf("invalid index %s in the path %s", part, path)
+			}
+			cur = node[i]
+		default:
+			return 0, fmt.Errorf("can not get %s in the path %s from %T", part, path, cur)
+		}
+	}
+
+	switch v := cur.(type) {
+	case json.Number:
+		return v.Float64()
+	case string:
+		return strconv.ParseFloat(v, 64)
+	default:
+		return 0, fmt.Errorf("value by the path %s is not a number: %T", path, cur)
+	}
+}
@@ -0,0 +1,27 @@
+package restClient
+
+import (
+	"fmt"
+
+	"oracle-flare/pkg/logger"
+)
+
+//func logFatal(msg string, method string) {
+//	logger.Log().WithField("layer", fmt.Sprintf("RestClient-%s", method)).Fatal(msg)
+//}
+
+func logWarn(msg string, method string) {
+	logger.Log().WithField("layer", fmt.Sprintf("RestClient-%s", method)).Warning(msg)
+}
+
+func logInfo(msg string, method string) {
+	logger.Log().WithField("layer", fmt.Sprintf("RestClient-%s", method)).Info(msg)
+}
+
+func logErr(msg string, method string) {
+	logger.Log().WithField("layer", fmt.Sprintf("RestClient-%s", method)).Error(msg)
+}
+
+func logDebug(msg string, method string) {
+	logger.Log().WithField("layer", fmt.Sprintf("RestClient-%s", method)).Debug(msg)
+}
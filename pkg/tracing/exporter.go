@@ -0,0 +1,42 @@
+package tracing
+
+import "fmt"
+
+// Exporter is a spans exporter type
+type Exporter int
+
+const (
+	UnknownExporter Exporter = iota
+	// NoExporter disables the tracing
+	NoExporter
+	// StdoutExporter writes the spans to stdout as JSON
+	StdoutExporter
+	// OTLPExporter sends the spans to the OTLP HTTP collector
+	OTLPExporter
+)
+
+var ExporterStrings = [...]string{
+	UnknownExporter: "UnknownExporter",
+	NoExporter:      "none",
+	StdoutExporter:  "stdout",
+	OTLPExporter:    "otlp",
+}
+
+// ExporterFromString is used to get the exporter from the given string
+func ExporterFromString(s string) (Exporter, error) {
+	switch s {
+	case NoExporter.String(), "":
+		return NoExporter, nil
+	case StdoutExporter.String():
+		return StdoutExporter, nil
+	case OTLPExporter.String():
+		return OTLPExporter, nil
+	default:
+		return UnknownExporter, fmt.Errorf("tracing exporter %s not supported", s)
+	}
+}
+
+// String is used to get Exporter string value
+func (e Exporter) String() string {
+	return ExporterStrings[e]
+}
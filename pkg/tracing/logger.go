@@ -0,0 +1,15 @@
+package tracing
+
+import (
+	"fmt"
+
+	"oracle-flare/pkg/logger"
+)
+
+func logWarn(msg string, method string) {
+	logger.Log().WithField("layer", fmt.Sprintf("Tracing-%s", method)).Warning(msg)
+}
+
+func logInfo(msg string, method string) {
+	logger.Log().WithField("layer", fmt.Sprintf("Tracing-%s", method)).Info(msg)
+}
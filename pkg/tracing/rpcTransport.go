@@ -0,0 +1,82 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+)
+
+// rpcTransport is a http.RoundTripper recording a span for each JSON-RPC request
+type rpcTransport struct {
+	base http.RoundTripper
+}
+
+// RPCTransport is used to get the http transport recording the "rpc <method>" span of each JSON-RPC request. Spans are
+// children of the request context span, e.g. of the transaction options context
+func RPCTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &rpcTransport{base: base}
+}
+
+func (t *rpcTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !Enabled() || req.Body == nil {
+		return t.base.RoundTrip(req)
+	}
+
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	method := rpcMethod(body)
+
+	ctx, span := Start(req.Context(), fmt.Sprintf("rpc %s", method),
+		semconv.RPCSystemKey.String("jsonrpc"),
+		semconv.RPCMethod(method),
+	)
+
+	req = req.Clone(ctx)
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	resp, err := t.base.RoundTrip(req)
+	if err == nil {
+		span.SetAttributes(attribute.Int("http.status_code", resp.StatusCode))
+		if resp.StatusCode >= http.StatusBadRequest {
+			err = fmt.Errorf("unexpected status: %s", resp.Status)
+		}
+	}
+	End(span, err)
+
+	return resp, err
+}
+
+// rpcMethod is used to get the JSON-RPC method name of the request body. Batch requests are named by the first
+// method
+func rpcMethod(body []byte) string {
+	var msg struct {
+		Method string `json:"method"`
+	}
+
+	if err := json.Unmarshal(body, &msg); err == nil {
+		return msg.Method
+	}
+
+	var batch []struct {
+		Method string `json:"method"`
+	}
+
+	if err := json.Unmarshal(body, &batch); err == nil && len(batch) > 0 {
+		return fmt.Sprintf("batch %s", batch[0].Method)
+	}
+
+	return "unknown"
+}
@@ -0,0 +1,134 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"oracle-flare/config"
+)
+
+// serviceName is the traced service and tracer name
+const serviceName = "oracle-flare"
+
+// shutdownTimeout is a max time to flush the spans on shutdown
+const shutdownTimeout = time.Second * 5
+
+// enabled is true if the spans are exported
+var enabled atomic.Bool
+
+// Init is used to set the global tracer provider with the configured exporter. Spans are not recorded if the exporter
+// is "none". Returns the function flushing the spans and stopping the exporter
+func Init(conf *config.Tracing, version string) (func(), error) {
+	if err := CheckConfig(conf); err != nil {
+		return nil, err
+	}
+
+	exporter, err := ExporterFromString(conf.Exporter)
+	if err != nil {
+		return nil, err
+	}
+
+	var spanExporter sdktrace.SpanExporter
+	switch exporter {
+	case NoExporter:
+		return func() {}, nil
+	case StdoutExporter:
+		spanExporter, err = stdouttrace.New()
+	case OTLPExporter:
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(conf.Endpoint)}
+		if conf.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+
+		spanExporter, err = otlptracehttp.New(context.Background(), opts...)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create %s exporter: %w", exporter, err)
+	}
+
+	res := resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+		semconv.ServiceVersion(version),
+	)
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(spanExporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(conf.SampleRatio))),
+	)
+
+	otel.SetTracerProvider(provider)
+	enabled.Store(true)
+
+	logInfo(fmt.Sprintf("spans are exported to %s, sample ratio: %v", exporter, conf.SampleRatio), "Init")
+
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		if err := provider.Shutdown(ctx); err != nil {
+			logWarn(fmt.Sprintln("err flush spans:", err.Error()), "Shutdown")
+		}
+	}, nil
+}
+
+// CheckConfig is used to validate the tracing config: the exporter name and the sample ratio
+func CheckConfig(conf *config.Tracing) error {
+	if _, err := ExporterFromString(conf.Exporter); err != nil {
+		return err
+	}
+
+	if conf.SampleRatio < 0 || conf.SampleRatio > 1 {
+		return fmt.Errorf("tracing sample ratio %v is not in [0, 1]", conf.SampleRatio)
+	}
+
+	return nil
+}
+
+// Enabled is used to check the spans are exported. It is used to skip the work needed by the tracing only
+func Enabled() bool {
+	return enabled.Load()
+}
+
+// Start is used to start the span as a child of the ctx span. The tracer is a no-op until Init is called
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(serviceName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End is used to end the span with the error status if err is not nil
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// Epoch is used to get the price epoch attributes
+func Epoch(chainID int, epochID fmt.Stringer) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.Int("chain_id", chainID),
+		attribute.String("epoch_id", epochID.String()),
+	}
+}
+
+// Tx is used to get the signer transaction attributes
+func Tx(hash string, nonce uint64) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("tx_hash", hash),
+		attribute.Int64("nonce", int64(nonce)),
+	}
+}
@@ -1,22 +1,68 @@
 package wsClient
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/websocket"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"oracle-flare/config"
+	"oracle-flare/pkg/internal/backoff"
+	"oracle-flare/pkg/tracing"
 )
 
+const (
+	// reconnectMinDelay is a min delay before the reconnect attempt
+	reconnectMinDelay = time.Millisecond * 500
+	// reconnectMaxDelay is a max delay before the reconnect attempt
+	reconnectMaxDelay = time.Second * 30
+	// stableConnection is a min connection time after which the reconnect backoff is reset. Connections dropped
+	// earlier keep increasing the delay, so a flapping server is not redialed in a tight loop
+	stableConnection = time.Second * 30
+	// writeWait is a time allowed to write a message to the server
+	writeWait = time.Second * 10
+	// pongWait is a time allowed to read the next message or pong from the server. The connection is considered
+	// half-open and is reconnected when it is exceeded
+	pongWait = time.Second * 60
+	// pingPeriod is a ping messages period. Must be less than pongWait
+	pingPeriod = pongWait * 9 / 10
+)
+
+// errClosed is returned when the client is closed during the request
+var errClosed = errors.New("client is closed")
+
 // IWSClient is a ws client pkg interface
 type IWSClient interface {
-	// SubscribeCoinAveragePrice is used to send subscribe msg for the prc coin_average_price method
+	// SubscribeCoinAveragePrice is used to send subscribe msg for the prc coin_average_price method. The subscription
+	// is registered and replayed on each reconnect. Returns an error if it is not confirmed by the server
 	SubscribeCoinAveragePrice(coins []string, id int, frequencyMS int, v chan *CoinAveragePriceStream) error
-	// Resubscribe is used to get the chanel for resubscribe-needed signal
-	Resubscribe() chan struct{}
+	// SubscribeCoinExchangePrice is used to send subscribe msg for the prc coin_exchange_price method. Prices are streamed
+	// for each exchange separately. All supported exchanges are used if exchanges are empty
+	SubscribeCoinExchangePrice(coins []string, exchanges []string, id int, frequencyMS int, v chan *CoinExchangePriceStream) error
+	// SubscribeCoinVWAP is used to send subscribe msg for the prc coin_vwap method. Prices are weighted by the trades
+	// volume for the windowMS period
+	SubscribeCoinVWAP(coins []string, id int, windowMS int, frequencyMS int, v chan *CoinVWAPStream) error
+	// SubscribeOrderBookMidPrice is used to send subscribe msg for the prc orderbook_mid_price method. Mid prices are
+	// streamed for each exchange separately. All supported exchanges are used if exchanges are empty
+	SubscribeOrderBookMidPrice(coins []string, exchanges []string, id int, frequencyMS int, v chan *OrderBookMidPriceStream) error
+	// Unsubscribe is used to remove the subscription with given id
+	Unsubscribe(id int) error
+	// Subscriptions is used to get all registered subscriptions
+	Subscriptions() []*SubscriptionInfo
+	// State is used to get current connection state
+	State() State
+	// SubscribeStates is used to get new chanel receiving connection state transitions
+	SubscribeStates() chan State
+	// SetURL is used to change the server url. The client is reconnected to the new url and all subscriptions are
+	// replayed
+	SetURL(url string)
 	// Close is used to close the service
 	Close()
 }
@@ -24,153 +70,401 @@ type IWSClient interface {
 // client is a ws client pkg struct implementing IWSClient interface
 type client struct {
 	conf *config.WS
-	conn *websocket.Conn
+	// policy is a subscription buffers overflow policy
+	policy OverflowPolicy
+	// url is the current server url. It is dialed on each reconnect
+	url atomic.Pointer[string]
 
+	// connMu guards the conn swap and all writes to the conn
+	connMu sync.Mutex
+	conn   *websocket.Conn
+
+	// mu guards the subscriptions registry
 	mu sync.Mutex
 
-	// streams mapping stream rpc id to the CoinAveragePriceStream chan
-	streams map[int]chan *CoinAveragePriceStream
-	// stop is used to stop the connections listener
-	stop chan struct{}
-	// resubscribe is used to send a resubscribe-needed signal
-	resubscribe chan struct{}
+	// subs mapping subscription rpc id to the registered subscription
+	subs map[int]*subscription
+	// unsubscribes mapping the sent unsubscribe rpc id to the subscription id. unsubscribeID is the next rpc id offset
+	unsubscribes  map[int]int
+	unsubscribeID int
+
+	// stateMu guards the state and state subscribers
+	stateMu   sync.Mutex
+	state     State
+	stateSubs []chan State
+
+	// stop is used to stop the connection loop. It is closed once by Close
+	stop      chan struct{}
+	closeOnce sync.Once
+	// done is closed when the connection loop is stopped
+	done chan struct{}
 }
 
-// NewClient is used to get new client instance
+// NewClient is used to get new client instance. The connection is established in the background, subscribe
+// requests return an error until the client is connected
 func NewClient(conf *config.WS) IWSClient {
-	c := &client{
-		conf: conf,
-		mu:   sync.Mutex{},
+	policy, err := OverflowPolicyFromString(conf.OverflowPolicy)
+	if err != nil {
+		logWarn(fmt.Sprintf("%s, %s is used", err.Error(), policy), "NewClient")
 	}
 
-	if err := c.init(); err != nil {
-		logFatal(fmt.Sprintln("err init ws:", err.Error()), "Init")
+	c := &client{
+		conf:         conf,
+		policy:       policy,
+		subs:         make(map[int]*subscription),
+		unsubscribes: make(map[int]int),
+		stop:         make(chan struct{}),
+		done:         make(chan struct{}),
 	}
-	go c.listenWS()
+
+	c.url.Store(&conf.URL)
+
+	go c.run()
 
 	return c
 }
 
-// init is used to init client dependencies
-func (c *client) init() error {
-	logInfo("new ws client init attempt...", "Init")
+// run is the connection state machine loop. It dials the server with the exponential backoff, reads the connection
+// until it fails and reconnects after the backoff delay until the client is closed. The backoff is reset only after
+// the stableConnection time
+func (c *client) run() {
+	defer close(c.done)
 
-	conn, _, err := websocket.DefaultDialer.Dial(c.conf.URL, nil)
-	if err != nil {
-		logWarn(fmt.Sprintln("err dial ws server during init, reconnecting in 5 sec err:", err.Error()), "Init")
-		time.Sleep(time.Second * 5)
-		return c.init()
+	b := backoff.New(reconnectMinDelay, reconnectMaxDelay)
+	c.setState(Connecting)
+
+	// each dial cycle is traced with the subscriptions replay as children
+	spanName := "ws.connect"
+
+	for {
+		ctx, span := tracing.Start(context.Background(), spanName)
+		spanName = "ws.reconnect"
+
+		conn, ok := c.dial(span, b)
+		if !ok {
+			tracing.End(span, errClosed)
+			c.setState(Closed)
+			return
+		}
+
+		c.connMu.Lock()
+		c.conn = conn
+		c.connMu.Unlock()
+
+		c.setState(Connected)
+		connectedAt := time.Now()
+
+		// confirmations are received by the listener, so subscriptions are replayed in the background
+		go c.replay(ctx, span)
+
+		pingDone := make(chan struct{})
+		go c.ping(conn, pingDone)
+
+		err := c.listenWS(conn)
+		close(pingDone)
+
+		c.connMu.Lock()
+		c.conn = nil
+		c.connMu.Unlock()
+		_ = conn.Close()
+
+		c.deactivate()
+
+		select {
+		case <-c.stop:
+			c.setState(Closed)
+			return
+		default:
+		}
+
+		if time.Since(connectedAt) >= stableConnection {
+			b.Reset()
+		}
+
+		delay := b.Next()
+		logWarn(fmt.Sprintf("connection lost, reconnecting in %v err: %s", delay, err.Error()), "Run")
+		c.setState(Reconnecting)
+
+		select {
+		case <-c.stop:
+			c.setState(Closed)
+			return
+		case <-time.After(delay):
+		}
+	}
+}
+
+// dial is used to dial the server until success. Failed attempts are recorded as the span events. Returns false if
+// the client is closed
+func (c *client) dial(span trace.Span, b *backoff.Backoff) (*websocket.Conn, bool) {
+	for attempt := 1; ; attempt++ {
+		logInfo("ws client connection attempt...", "Dial")
+
+		conn, _, err := websocket.DefaultDialer.Dial(*c.url.Load(), nil)
+		if err == nil {
+			span.SetAttributes(attribute.Int("attempts", attempt))
+			return conn, true
+		}
+
+		delay := b.Next()
+		span.AddEvent("dial failed", trace.WithAttributes(
+			attribute.String("error", err.Error()),
+			attribute.String("retry_in", delay.String()),
+		))
+		logWarn(fmt.Sprintf("err dial ws server, reconnecting in %v err: %s", delay, err.Error()), "Dial")
+
+		select {
+		case <-c.stop:
+			return nil, false
+		case <-time.After(delay):
+		}
+	}
+}
+
+// ping is used to send ping messages to the server until done is closed
+func (c *client) ping(conn *websocket.Conn, done chan struct{}) {
+	ticker := time.NewTicker(pingPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			c.connMu.Lock()
+			err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeWait))
+			c.connMu.Unlock()
+
+			if err != nil {
+				logWarn(fmt.Sprintln("err send ping:", err.Error()), "Ping")
+				return
+			}
+		}
+	}
+}
+
+// write is used to safely write the message to the current connection
+func (c *client) write(data []byte) error {
+	c.connMu.Lock()
+	defer c.connMu.Unlock()
+
+	if c.conn == nil {
+		return fmt.Errorf("ws client is not connected, state: %s", c.State())
 	}
 
-	c.streams = make(map[int]chan *CoinAveragePriceStream)
-	c.conn = conn
-	c.stop = make(chan struct{})
-	c.resubscribe = make(chan struct{})
+	if err := c.conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
+		return err
+	}
 
-	return nil
+	return c.conn.WriteMessage(websocket.TextMessage, data)
 }
 
-// Close is used to stop the service
+// Close is used to stop the service. Subsequent calls wait for the first one
 func (c *client) Close() {
+	c.closeOnce.Do(c.close)
+}
+
+// close is used to stop the connection loop and close the subscriptions
+func (c *client) close() {
 	logInfo("closing ws client...", "Close")
-	if c.conn != nil {
-		close(c.stop)
 
-		// wait till listener stop
-		time.Sleep(time.Millisecond * 500)
+	close(c.stop)
+
+	// unblock the reader
+	c.connMu.Lock()
+	if c.conn != nil {
+		_ = c.conn.WriteControl(
+			websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(writeWait),
+		)
 		if err := c.conn.Close(); err != nil {
 			logWarn(fmt.Sprintln("err close connection:", err.Error()), "Close")
 		}
 	}
+	c.connMu.Unlock()
+
+	<-c.done
+
+	c.mu.Lock()
+	for _, sub := range c.subs {
+		sub.sink.close()
+	}
+	c.subs = make(map[int]*subscription)
+	c.mu.Unlock()
 }
 
-func (c *client) Resubscribe() chan struct{} {
-	return c.resubscribe
+func (c *client) SetURL(url string) {
+	if old := c.url.Swap(&url); *old == url {
+		return
+	}
+
+	logInfo(fmt.Sprintln("server url changed, reconnecting to", url), "SetURL")
+
+	// the reader fails on the closed connection and the run loop dials the new url
+	c.connMu.Lock()
+	if c.conn != nil {
+		_ = c.conn.Close()
+	}
+	c.connMu.Unlock()
 }
 
-// Reconnect is used to reconnect to the ws server when unexpected close error occurred
-func (c *client) reconnect() {
-	logWarn("reconnecting...", "Reconnect")
-	time.Sleep(time.Second * 5)
+func (c *client) State() State {
+	c.stateMu.Lock()
+	defer c.stateMu.Unlock()
 
-	conn, _, err := websocket.DefaultDialer.Dial(c.conf.URL, nil)
-	if err != nil {
-		logWarn(fmt.Sprintln("reconnect err:", err.Error()), "Reconnect")
-		go c.reconnect()
+	return c.state
+}
+
+func (c *client) SubscribeStates() chan State {
+	ch := make(chan State, 5)
+
+	c.stateMu.Lock()
+	c.stateSubs = append(c.stateSubs, ch)
+	c.stateMu.Unlock()
+
+	return ch
+}
+
+// setState is used to change the state and notify the state subscribers
+func (c *client) setState(s State) {
+	c.stateMu.Lock()
+	defer c.stateMu.Unlock()
+
+	if c.state == s {
 		return
 	}
 
-	c.conn = conn
+	logInfo(fmt.Sprintf("state %s -> %s", c.state, s), "State")
+	c.state = s
 
-	go c.listenWS()
-	c.resubscribe <- struct{}{}
+	for _, ch := range c.stateSubs {
+		select {
+		case ch <- s:
+		default:
+			logWarn(fmt.Sprintf("state subscriber is busy, %s state skipped", s), "State")
+		}
+	}
 }
 
-// listenWS is used to listen to the ws connection
-func (c *client) listenWS() {
+// listenWS is used to listen to the ws connection. Returns the read error
+func (c *client) listenWS(conn *websocket.Conn) error {
 	logInfo("listening to ws oracle...", "listenWS")
 
-	for {
-		select {
-		case <-c.stop:
-			logInfo("stop listen", "listenWS")
-			return
-		default:
-			_, data, err := c.conn.ReadMessage()
-			if err != nil {
-				if websocket.IsUnexpectedCloseError(err) {
-					logWarn(fmt.Sprintln("unexpected close err:", err.Error()), "listenWS")
-					go c.reconnect()
-					return
-				}
-				// error can be occurred when stop the service
-				logWarn(fmt.Sprintln("err from server:", err.Error()), "listenWS")
-				return
-			}
+	if err := conn.SetReadDeadline(time.Now().Add(pongWait)); err != nil {
+		return err
+	}
+
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
 
-			logDebug("received msg", "listenWS")
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return err
+		}
 
-			logSuccess(data)
-			c.sendData(data)
+		if err := conn.SetReadDeadline(time.Now().Add(pongWait)); err != nil {
+			return err
 		}
+
+		logDebug("received msg", "listenWS")
+
+		c.handleMessage(data)
+	}
+}
+
+// handleMessage is a handler for all messages from the server
+func (c *client) handleMessage(data []byte) {
+	if c.handleError(data) {
+		return
+	}
+
+	if c.handleSuccess(data) {
+		return
 	}
+
+	c.sendData(data)
 }
 
-// sendData is a handler for data reposes. Can be expanded for several stream types
+// sendData is a handler for data reposes of all stream types. The data is pushed to the subscription buffer, so the
+// listener is never blocked by the consumer
 func (c *client) sendData(data []byte) {
-	dataResp := &CoinAveragePriceResponse{}
-	err := json.Unmarshal(data, dataResp)
-	if err != nil {
+	dataResp := &DataResponse{}
+	if err := json.Unmarshal(data, dataResp); err != nil {
 		logWarn(fmt.Sprintln("err decode data msg:", err.Error()), "listenWS")
 		return
 	}
 
-	if dataResp.Result.Timestamp != 0 {
-		c.mu.Lock()
-		c.streams[dataResp.ID] <- &CoinAveragePriceStream{
-			Coin:      dataResp.Result.Coin,
-			Timestamp: dataResp.Result.Timestamp,
-			Value:     dataResp.Result.Value,
-		}
-		c.mu.Unlock()
+	if len(dataResp.Result) == 0 {
+		return
 	}
-}
 
-// TODO: add error handlers
+	header := &DataHeader{}
+	if err := json.Unmarshal(dataResp.Result, header); err != nil || header.Timestamp == 0 {
+		return
+	}
+
+	c.mu.Lock()
+	sub, ok := c.subs[dataResp.ID]
+	c.mu.Unlock()
+
+	if !ok {
+		logDebug(fmt.Sprintf("received data for unknown subscription %v", dataResp.ID), "listenWS", subFields(dataResp.ID))
+		return
+	}
+
+	if err := sub.sink.push(dataResp.Result); err != nil {
+		logWarn(fmt.Sprintf("err decode data msg for subscription %v: %s", dataResp.ID, err.Error()), "listenWS", subFields(dataResp.ID))
+	}
+}
 
-// logSuccess is a handler for success messages
-func logSuccess(data []byte) {
+// handleSuccess is a handler for success messages. Returns true if the message is a success message
+func (c *client) handleSuccess(data []byte) bool {
 	successfulResp := &SuccessfulResponse{}
 	err := json.Unmarshal(data, successfulResp)
 	if err != nil {
 		logWarn(fmt.Sprintln("err decode successful msg:", err.Error()), "listenWS")
-		return
+		return false
 	}
 
-	if successfulResp.Result.Message != "" {
-		logInfo(
-			fmt.Sprintf("%s for %s id:%v", successfulResp.Result.Message, successfulResp.Result.Method, successfulResp.ID),
-			"listenWS",
-		)
+	if successfulResp.Result == nil || successfulResp.Result.Message == "" {
+		return false
+	}
+
+	logInfo(
+		fmt.Sprintf("%s for %s id:%v", successfulResp.Result.Message, successfulResp.Result.Method, successfulResp.ID),
+		"listenWS",
+		subFields(successfulResp.ID),
+	)
+
+	c.confirm(successfulResp.ID, nil)
+
+	return true
+}
+
+// handleError is a handler for error messages. Returns true if the message is an error message
+func (c *client) handleError(data []byte) bool {
+	errorResp := &ErrorResponse{}
+	if err := json.Unmarshal(data, errorResp); err != nil || errorResp.Error == nil {
+		return false
 	}
+
+	logErr(fmt.Sprintf("error from server id:%v code:%v %s", errorResp.ID, errorResp.Error.Code, errorResp.Error.Message), "listenWS", subFields(errorResp.ID))
+
+	c.confirm(errorResp.ID, fmt.Errorf("code %v: %s", errorResp.Error.Code, errorResp.Error.Message))
+
+	return true
+}
+
+// CheckURL is used to check the ws server at the url accepts connections
+func CheckURL(url string) error {
+	dialer := *websocket.DefaultDialer
+	dialer.HandshakeTimeout = writeWait
+
+	conn, _, err := dialer.Dial(url, nil)
+	if err != nil {
+		return err
+	}
+
+	return conn.Close()
 }
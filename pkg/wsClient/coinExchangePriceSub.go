@@ -0,0 +1,27 @@
+package wsClient
+
+import (
+	"fmt"
+)
+
+// SubscribeCoinExchangePrice is used to send subscribe msg for the prc coin_exchange_price method
+func (c *client) SubscribeCoinExchangePrice(coins []string, exchanges []string, id int, frequencyMS int, v chan *CoinExchangePriceStream) error {
+	logInfo(fmt.Sprintln("subscribing on coins:", coins, "exchanges:", exchanges), "SubscribeCoinExchangePrice", subFields(id))
+
+	params := &CoinExchangePriceParams{
+		Coins:       coins,
+		Exchanges:   exchanges,
+		FrequencyMS: frequencyMS,
+	}
+
+	// prices are coalesced per coin and exchange pair
+	err := subscribeStream(c, id, "coin_exchange_price", params, v, func(s *CoinExchangePriceStream) string {
+		return s.Coin + "/" + s.Exchange
+	})
+	if err != nil {
+		logErr(fmt.Sprintln("err subscribe:", err.Error()), "SubscribeCoinExchangePrice", subFields(id))
+		return err
+	}
+
+	return nil
+}
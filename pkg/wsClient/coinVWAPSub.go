@@ -0,0 +1,26 @@
+package wsClient
+
+import (
+	"fmt"
+)
+
+// SubscribeCoinVWAP is used to send subscribe msg for the prc coin_vwap method
+func (c *client) SubscribeCoinVWAP(coins []string, id int, windowMS int, frequencyMS int, v chan *CoinVWAPStream) error {
+	logInfo(fmt.Sprintln("subscribing on coins:", coins, "window ms:", windowMS), "SubscribeCoinVWAP", subFields(id))
+
+	params := &CoinVWAPParams{
+		Coins:       coins,
+		WindowMS:    windowMS,
+		FrequencyMS: frequencyMS,
+	}
+
+	err := subscribeStream(c, id, "coin_vwap", params, v, func(s *CoinVWAPStream) string {
+		return s.Coin
+	})
+	if err != nil {
+		logErr(fmt.Sprintln("err subscribe:", err.Error()), "SubscribeCoinVWAP", subFields(id))
+		return err
+	}
+
+	return nil
+}
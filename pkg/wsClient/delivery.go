@@ -0,0 +1,194 @@
+package wsClient
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// defaultBufferSize is a subscription buffer size used if it is not set in the config
+const defaultBufferSize = 100
+
+// OverflowPolicy is a subscription buffer overflow policy type
+type OverflowPolicy int
+
+const (
+	// DropOldest drops the oldest buffered message when the buffer is full
+	DropOldest OverflowPolicy = iota
+	// CoalesceLatest keeps only the latest buffered message per coin (or coin and exchange pair for per-exchange
+	// streams). The oldest message is dropped when the buffer is full of different keys
+	CoalesceLatest
+)
+
+var OverflowPolicyStrings = [...]string{
+	DropOldest:     "drop_oldest",
+	CoalesceLatest: "coalesce_latest",
+}
+
+// String is used to get OverflowPolicy string value
+func (p OverflowPolicy) String() string {
+	return OverflowPolicyStrings[p]
+}
+
+// OverflowPolicyFromString is used to get OverflowPolicy from the given string. CoalesceLatest is used for the empty string
+func OverflowPolicyFromString(s string) (OverflowPolicy, error) {
+	switch s {
+	case "", CoalesceLatest.String():
+		return CoalesceLatest, nil
+	case DropOldest.String():
+		return DropOldest, nil
+	default:
+		return CoalesceLatest, fmt.Errorf("unknown overflow policy: %s", s)
+	}
+}
+
+// sink is a type independent subscription data receiver
+type sink interface {
+	// push is used to decode the rpc result and buffer it for the consumer
+	push(result json.RawMessage) error
+	// dropped is used to get a number of messages dropped because of the slow consumer
+	dropped() uint64
+	// close is used to stop the delivery to the consumer
+	close()
+}
+
+// delivery is a bounded buffer between the connection listener and the subscription consumer of the T stream model.
+// Push never blocks, so slow or stopped consumer can not stall the listener
+type delivery[T any] struct {
+	id     int
+	policy OverflowPolicy
+	size   int
+	// key is used to get the message coalesce key, e.g. a coin
+	key func(*T) string
+
+	mu    sync.Mutex
+	queue []*T
+
+	// notify is used to wake up the delivery loop after push
+	notify chan struct{}
+	stop   chan struct{}
+	out    chan *T
+
+	droppedN atomic.Uint64
+}
+
+// newDelivery is used to get new delivery instance and start the delivery loop
+func newDelivery[T any](id int, policy OverflowPolicy, size int, out chan *T, key func(*T) string) *delivery[T] {
+	if size < 1 {
+		size = defaultBufferSize
+	}
+
+	d := &delivery[T]{
+		id:     id,
+		policy: policy,
+		size:   size,
+		key:    key,
+		queue:  make([]*T, 0, size),
+		notify: make(chan struct{}, 1),
+		stop:   make(chan struct{}),
+		out:    out,
+	}
+
+	go d.run()
+
+	return d
+}
+
+func (d *delivery[T]) push(result json.RawMessage) error {
+	msg := new(T)
+	if err := json.Unmarshal(result, msg); err != nil {
+		return err
+	}
+
+	d.pushMsg(msg)
+
+	return nil
+}
+
+// pushMsg is used to buffer the message according to the overflow policy
+func (d *delivery[T]) pushMsg(msg *T) {
+	d.mu.Lock()
+
+	dropped := false
+
+	if d.policy == CoalesceLatest {
+		k := d.key(msg)
+		for i, m := range d.queue {
+			if d.key(m) == k {
+				d.queue[i] = msg
+				dropped = true
+				break
+			}
+		}
+	}
+
+	if !dropped {
+		if len(d.queue) >= d.size {
+			d.queue = d.queue[1:]
+			dropped = true
+		}
+
+		d.queue = append(d.queue, msg)
+	}
+
+	d.mu.Unlock()
+
+	if dropped {
+		if n := d.droppedN.Add(1); n == 1 || n%100 == 0 {
+			logWarn(fmt.Sprintf("subscription %v consumer is slow, %v messages dropped (%s)", d.id, n, d.policy), "Delivery", subFields(d.id))
+		}
+	}
+
+	select {
+	case d.notify <- struct{}{}:
+	default:
+	}
+}
+
+// pop is used to get the oldest buffered message
+func (d *delivery[T]) pop() (*T, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if len(d.queue) == 0 {
+		return nil, false
+	}
+
+	msg := d.queue[0]
+	d.queue = d.queue[1:]
+
+	return msg, true
+}
+
+// run is the delivery loop sending buffered messages to the consumer
+func (d *delivery[T]) run() {
+	for {
+		select {
+		case <-d.stop:
+			return
+		case <-d.notify:
+		}
+
+		for {
+			msg, ok := d.pop()
+			if !ok {
+				break
+			}
+
+			select {
+			case <-d.stop:
+				return
+			case d.out <- msg:
+			}
+		}
+	}
+}
+
+func (d *delivery[T]) dropped() uint64 {
+	return d.droppedN.Load()
+}
+
+func (d *delivery[T]) close() {
+	close(d.stop)
+}
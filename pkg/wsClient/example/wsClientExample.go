@@ -10,7 +10,8 @@ import (
 
 /**
 This is an example of the wsClient pkg usage. It is similar to the usage in the app internal service layer.
-You can play with the SubscribeCoinAveragePrice params, mainly with the coins and frequency params
+You can play with the SubscribeCoinAveragePrice params, mainly with the coins and frequency params.
+Other streams (SubscribeCoinExchangePrice, SubscribeCoinVWAP, SubscribeOrderBookMidPrice) are used the same way
 */
 
 func main() {
@@ -18,6 +19,7 @@ func main() {
 	defer c.Close()
 
 	stream := make(chan *wsClient.CoinAveragePriceStream)
+	mid := make(chan *wsClient.OrderBookMidPriceStream)
 	stop := make(chan struct{})
 	defer close(stop)
 
@@ -29,6 +31,8 @@ func main() {
 				return
 			case v := <-stream:
 				log.Printf("received coin: %s, value: %v, timestamp: %v", v.Coin, v.Value, v.Timestamp)
+			case v := <-mid:
+				log.Printf("received coin: %s, exchange: %s, mid: %v, timestamp: %v", v.Coin, v.Exchange, v.Value, v.Timestamp)
 			}
 		}
 	}()
@@ -38,5 +42,9 @@ func main() {
 		log.Fatal(err)
 	}
 
+	if err := c.SubscribeOrderBookMidPrice([]string{"ETH"}, []string{"binance"}, 2, 1000, mid); err != nil {
+		log.Fatal(err)
+	}
+
 	time.Sleep(time.Second * 10)
 }
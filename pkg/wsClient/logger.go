@@ -6,22 +6,27 @@ import (
 	"oracle-flare/pkg/logger"
 )
 
-func logFatal(msg string, method string) {
-	logger.Log().WithField("layer", fmt.Sprintf("WSClient-%s", method)).Fatal(msg)
+//func logFatal(msg string, method string) {
+//	logger.Log().WithField("layer", fmt.Sprintf("WSClient-%s", method)).Fatal(msg)
+//}
+
+func logWarn(msg string, method string, fields ...logger.Fields) {
+	logger.WithLayer(fmt.Sprintf("WSClient-%s", method), fields...).Warning(msg)
 }
 
-func logWarn(msg string, method string) {
-	logger.Log().WithField("layer", fmt.Sprintf("WSClient-%s", method)).Warning(msg)
+func logInfo(msg string, method string, fields ...logger.Fields) {
+	logger.WithLayer(fmt.Sprintf("WSClient-%s", method), fields...).Info(msg)
 }
 
-func logInfo(msg string, method string) {
-	logger.Log().WithField("layer", fmt.Sprintf("WSClient-%s", method)).Info(msg)
+func logErr(msg string, method string, fields ...logger.Fields) {
+	logger.WithLayer(fmt.Sprintf("WSClient-%s", method), fields...).Error(msg)
 }
 
-func logErr(msg string, method string) {
-	logger.Log().WithField("layer", fmt.Sprintf("WSClient-%s", method)).Error(msg)
+func logDebug(msg string, method string, fields ...logger.Fields) {
+	logger.WithLayer(fmt.Sprintf("WSClient-%s", method), fields...).Debug(msg)
 }
 
-func logDebug(msg string, method string) {
-	logger.Log().WithField("layer", fmt.Sprintf("WSClient-%s", method)).Debug(msg)
+// subFields is used to get the log fields of the subscription
+func subFields(id interface{}) logger.Fields {
+	return logger.Fields{logger.FieldWSSubscriptionID: id}
 }
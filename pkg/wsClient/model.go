@@ -1,5 +1,7 @@
 package wsClient
 
+import "encoding/json"
+
 // CoinAveragePriceParams is a params model for the coin_average_price rpc method for the CoinAveragePriceRequest model
 type CoinAveragePriceParams struct {
 	// Coins is a coins slice, should be supported by the rpc service
@@ -49,4 +51,105 @@ type CoinAveragePriceStream struct {
 	Coin      string  `json:"coin"`
 	Timestamp int     `json:"timestamp"`
 	Value     float64 `json:"value"`
+	// Quote is a quote currency of the value. USD is used if it is empty
+	Quote string `json:"quote,omitempty"`
+}
+
+// Request is a generic request model for the rpc methods
+type Request struct {
+	ID      int         `json:"id"`
+	JSONRPC string      `json:"jsonrpc"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params"`
+}
+
+// UnsubscribeParams is a params model for the unsubscribe rpc method
+type UnsubscribeParams struct {
+	// ID is the subscription id to cancel
+	ID int `json:"id"`
+}
+
+// ErrorResult is an error model from the rpc service for the ErrorResponse model
+type ErrorResult struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// ErrorResponse is a response model for error messages from the rpc
+type ErrorResponse struct {
+	ID      int          `json:"id"`
+	JSONRPC string       `json:"jsonrpc"`
+	Error   *ErrorResult `json:"error"`
+}
+
+// DataResponse is a generic response model for the data messages of all stream rpc methods
+type DataResponse struct {
+	ID      int             `json:"id"`
+	JSONRPC string          `json:"jsonrpc"`
+	Result  json.RawMessage `json:"result"`
+}
+
+// DataHeader is a common part of the data messages results of all stream rpc methods
+type DataHeader struct {
+	Method    string `json:"method"`
+	Timestamp int    `json:"timestamp"`
+}
+
+// CoinExchangePriceParams is a params model for the coin_exchange_price rpc method
+type CoinExchangePriceParams struct {
+	// Coins is a coins slice, should be supported by the rpc service
+	Coins []string `json:"coins"`
+	// Exchanges is an exchanges slice. All supported exchanges are used if it is empty
+	Exchanges []string `json:"exchanges,omitempty"`
+	// FrequencyMS is a return data frequency in milliseconds
+	FrequencyMS int `json:"frequency_ms"`
+}
+
+// CoinExchangePriceStream is a stream data model for the coin_exchange_price rpc method
+type CoinExchangePriceStream struct {
+	Coin      string  `json:"coin"`
+	Exchange  string  `json:"exchange"`
+	Timestamp int     `json:"timestamp"`
+	Value     float64 `json:"value"`
+}
+
+// CoinVWAPParams is a params model for the coin_vwap rpc method
+type CoinVWAPParams struct {
+	// Coins is a coins slice, should be supported by the rpc service
+	Coins []string `json:"coins"`
+	// WindowMS is a trades window in milliseconds used for the price weighting
+	WindowMS int `json:"window_ms"`
+	// FrequencyMS is a return data frequency in milliseconds
+	FrequencyMS int `json:"frequency_ms"`
+}
+
+// CoinVWAPStream is a stream data model for the coin_vwap rpc method
+type CoinVWAPStream struct {
+	Coin      string `json:"coin"`
+	Timestamp int    `json:"timestamp"`
+	// Value is a volume-weighted average price
+	Value float64 `json:"value"`
+	// Volume is a trades volume for the window in the coin units
+	Volume float64 `json:"volume"`
+}
+
+// OrderBookMidPriceParams is a params model for the orderbook_mid_price rpc method
+type OrderBookMidPriceParams struct {
+	// Coins is a coins slice, should be supported by the rpc service
+	Coins []string `json:"coins"`
+	// Exchanges is an exchanges slice. All supported exchanges are used if it is empty
+	Exchanges []string `json:"exchanges,omitempty"`
+	// FrequencyMS is a return data frequency in milliseconds
+	FrequencyMS int `json:"frequency_ms"`
+}
+
+// OrderBookMidPriceStream is a stream data model for the orderbook_mid_price rpc method
+type OrderBookMidPriceStream struct {
+	Coin      string  `json:"coin"`
+	Exchange  string  `json:"exchange"`
+	Timestamp int     `json:"timestamp"`
+	Bid       float64 `json:"bid"`
+	Ask       float64 `json:"ask"`
+	// Value is a mid price between the best bid and ask
+	Value float64 `json:"value"`
 }
@@ -0,0 +1,27 @@
+package wsClient
+
+import (
+	"fmt"
+)
+
+// SubscribeOrderBookMidPrice is used to send subscribe msg for the prc orderbook_mid_price method
+func (c *client) SubscribeOrderBookMidPrice(coins []string, exchanges []string, id int, frequencyMS int, v chan *OrderBookMidPriceStream) error {
+	logInfo(fmt.Sprintln("subscribing on coins:", coins, "exchanges:", exchanges), "SubscribeOrderBookMidPrice", subFields(id))
+
+	params := &OrderBookMidPriceParams{
+		Coins:       coins,
+		Exchanges:   exchanges,
+		FrequencyMS: frequencyMS,
+	}
+
+	// mid prices are coalesced per coin and exchange pair
+	err := subscribeStream(c, id, "orderbook_mid_price", params, v, func(s *OrderBookMidPriceStream) string {
+		return s.Coin + "/" + s.Exchange
+	})
+	if err != nil {
+		logErr(fmt.Sprintln("err subscribe:", err.Error()), "SubscribeOrderBookMidPrice", subFields(id))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package wsClient
+
+// State is a ws connection state type
+type State int
+
+const (
+	// Disconnected is a state before the first connection attempt
+	Disconnected State = iota
+	// Connecting is a state of the first connection attempts
+	Connecting
+	// Connected is a state of the established connection
+	Connected
+	// Reconnecting is a state of the connection attempts after the connection is lost
+	Reconnecting
+	// Closed is a final state after the client is closed
+	Closed
+)
+
+var StateStrings = [...]string{
+	Disconnected: "Disconnected",
+	Connecting:   "Connecting",
+	Connected:    "Connected",
+	Reconnecting: "Reconnecting",
+	Closed:       "Closed",
+}
+
+// String is used to get State string value
+func (s State) String() string {
+	return StateStrings[s]
+}
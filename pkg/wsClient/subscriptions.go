@@ -0,0 +1,248 @@
+package wsClient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"oracle-flare/pkg/tracing"
+)
+
+const (
+	// confirmTimeout is a time to wait for the subscribe request confirmation from the server
+	confirmTimeout = time.Second * 10
+	// unsubscribeMethod is an rpc method used to cancel the subscription with given id
+	unsubscribeMethod = "unsubscribe"
+	// unsubscribeIDBase is the first rpc id of the unsubscribe requests. Subscribe requests use the subscription ids, so
+	// the unsubscribe responses are never taken as the confirmation of the subscription with the same id
+	unsubscribeIDBase = 1 << 30
+)
+
+// subscription is a registered subscription. All registered subscriptions are replayed by the client on each reconnect
+type subscription struct {
+	id     int
+	method string
+	params interface{}
+
+	// sink buffers the subscription data for the stream consumer
+	sink sink
+
+	// active is true when the subscribe request is confirmed by the server on the current connection
+	active bool
+	// confirm receives the server response for the last sent subscribe request
+	confirm chan error
+}
+
+// SubscriptionInfo is a registered subscription state model
+type SubscriptionInfo struct {
+	ID     int
+	Method string
+	Params interface{}
+	// Active is true when the subscription is confirmed by the server on the current connection
+	Active bool
+	// Dropped is a number of messages dropped because of the slow consumer
+	Dropped uint64
+}
+
+// subscribe is used to register the subscription and send the subscribe request. The subscription stays registered if
+// the request is not confirmed because of the connection issues and is sent again on reconnect. Subscription rejected by
+// the server is removed
+func (c *client) subscribe(sub *subscription) error {
+	c.mu.Lock()
+	old, ok := c.subs[sub.id]
+	c.subs[sub.id] = sub
+	c.mu.Unlock()
+
+	if ok {
+		old.sink.close()
+	}
+
+	if err := c.sendSubscribe(context.Background(), sub); err != nil {
+		return fmt.Errorf("subscription %v is not active: %w", sub.id, err)
+	}
+
+	return nil
+}
+
+// subscribeStream is used to register the subscription delivering the rpc results decoded as the T stream model to
+// the v chanel. key is used to get the coalesce key of the message
+func subscribeStream[T any](c *client, id int, method string, params interface{}, v chan *T, key func(*T) string) error {
+	return c.subscribe(&subscription{
+		id:     id,
+		method: method,
+		params: params,
+		sink:   newDelivery(id, c.policy, c.conf.BufferSize, v, key),
+	})
+}
+
+// sendSubscribe is used to send the subscribe request and wait for the server confirmation. It is traced as the ctx
+// span child
+func (c *client) sendSubscribe(ctx context.Context, sub *subscription) (err error) {
+	_, span := tracing.Start(ctx, "ws.subscribe", attribute.Int("subscription_id", sub.id), attribute.String("method", sub.method))
+	defer func() { tracing.End(span, err) }()
+
+	data, err := json.Marshal(&Request{
+		ID:      sub.id,
+		JSONRPC: "2.0",
+		Method:  sub.method,
+		Params:  sub.params,
+	})
+	if err != nil {
+		return err
+	}
+
+	confirm := make(chan error, 1)
+
+	c.mu.Lock()
+	sub.active = false
+	sub.confirm = confirm
+	c.mu.Unlock()
+
+	if err := c.write(data); err != nil {
+		return err
+	}
+
+	select {
+	case err := <-confirm:
+		if err != nil {
+			c.mu.Lock()
+			removed := c.subs[sub.id] == sub
+			if removed {
+				delete(c.subs, sub.id)
+			}
+			c.mu.Unlock()
+
+			if removed {
+				sub.sink.close()
+			}
+
+			return fmt.Errorf("rejected by the server: %w", err)
+		}
+
+		logInfo(fmt.Sprintf("subscription %v to %s is active", sub.id, sub.method), "Subscribe", subFields(sub.id))
+		return nil
+	case <-time.After(confirmTimeout):
+		return fmt.Errorf("no confirmation in %v", confirmTimeout)
+	case <-c.stop:
+		return errClosed
+	}
+}
+
+// confirm is used to mark the subscription with given id as confirmed or rejected with the server response. The
+// unsubscribe responses are only logged
+func (c *client) confirm(id int, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if subID, ok := c.unsubscribes[id]; ok {
+		delete(c.unsubscribes, id)
+
+		if err != nil {
+			logWarn(fmt.Sprintf("err unsubscribe %v: %s", subID, err.Error()), "Unsubscribe", subFields(subID))
+		}
+
+		return
+	}
+
+	sub, ok := c.subs[id]
+	if !ok || sub.confirm == nil {
+		return
+	}
+
+	sub.active = err == nil
+	sub.confirm <- err
+	sub.confirm = nil
+}
+
+// replay is used to send all registered subscriptions after the connection is established. The connection span is
+// ended when all subscriptions are sent
+func (c *client) replay(ctx context.Context, span trace.Span) {
+	defer span.End()
+
+	c.mu.Lock()
+	subs := make([]*subscription, 0, len(c.subs))
+	for _, sub := range c.subs {
+		subs = append(subs, sub)
+	}
+	c.mu.Unlock()
+
+	for _, sub := range subs {
+		logInfo(fmt.Sprintf("replaying subscription %v to %s", sub.id, sub.method), "Replay", subFields(sub.id))
+
+		if err := c.sendSubscribe(ctx, sub); err != nil {
+			logWarn(fmt.Sprintf("err replay subscription %v: %s", sub.id, err.Error()), "Replay", subFields(sub.id))
+		}
+	}
+}
+
+// deactivate is used to mark all subscriptions as not active when the connection is lost
+func (c *client) deactivate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, sub := range c.subs {
+		sub.active = false
+	}
+}
+
+// Unsubscribe is used to remove the subscription with given id and send the unsubscribe request. The request has its
+// own rpc id, so the subscription can be registered again with the same id right away
+func (c *client) Unsubscribe(id int) error {
+	c.mu.Lock()
+	sub, ok := c.subs[id]
+	delete(c.subs, id)
+
+	rpcID := unsubscribeIDBase + c.unsubscribeID
+	if ok {
+		c.unsubscribeID++
+		c.unsubscribes[rpcID] = id
+	}
+	c.mu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("no subscription with id %v", id)
+	}
+
+	sub.sink.close()
+
+	data, err := json.Marshal(&Request{
+		ID:      rpcID,
+		JSONRPC: "2.0",
+		Method:  unsubscribeMethod,
+		Params:  &UnsubscribeParams{ID: id},
+	})
+	if err == nil {
+		err = c.write(data)
+	}
+
+	if err != nil {
+		c.mu.Lock()
+		delete(c.unsubscribes, rpcID)
+		c.mu.Unlock()
+	}
+
+	return err
+}
+
+// Subscriptions is used to get all registered subscriptions
+func (c *client) Subscriptions() []*SubscriptionInfo {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	res := make([]*SubscriptionInfo, 0, len(c.subs))
+	for _, sub := range c.subs {
+		res = append(res, &SubscriptionInfo{
+			ID:      sub.id,
+			Method:  sub.method,
+			Params:  sub.params,
+			Active:  sub.active,
+			Dropped: sub.sink.dropped(),
+		})
+	}
+
+	return res
+}
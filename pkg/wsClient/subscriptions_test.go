@@ -0,0 +1,87 @@
+package wsClient
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"oracle-flare/config"
+)
+
+// newRejectingServer is used to get a local ws server confirming the subscribe requests and rejecting the unsubscribe
+// requests. The responses are sent in the requests order
+func newRejectingServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("err upgrade: %s", err)
+			return
+		}
+		defer conn.Close()
+
+		for {
+			req := &Request{}
+			if err := conn.ReadJSON(req); err != nil {
+				return
+			}
+
+			var resp interface{} = &SuccessfulResponse{
+				ID:      req.ID,
+				JSONRPC: "2.0",
+				Result:  &SuccessfulResult{Message: "subscribed", Method: req.Method},
+			}
+			if req.Method == unsubscribeMethod {
+				resp = &ErrorResponse{ID: req.ID, JSONRPC: "2.0", Error: &ErrorResult{Code: 1, Message: "unknown subscription"}}
+			}
+
+			b, _ := json.Marshal(resp)
+			if err := conn.WriteMessage(websocket.TextMessage, b); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestResubscribeSameID(t *testing.T) {
+	srv := newRejectingServer(t)
+
+	c := NewClient(&config.WS{URL: "ws" + strings.TrimPrefix(srv.URL, "http"), BufferSize: 10})
+	t.Cleanup(c.Close)
+
+	deadline := time.Now().Add(time.Second * 5)
+	for c.State() != Connected {
+		if time.Now().After(deadline) {
+			t.Fatal("ws client is not connected")
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	if err := c.SubscribeCoinAveragePrice([]string{"BTC"}, 1, 1000, make(chan *CoinAveragePriceStream)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Unsubscribe(1); err != nil {
+		t.Fatal(err)
+	}
+
+	// the rejected unsubscribe response is received before the subscribe confirmation
+	if err := c.SubscribeCoinAveragePrice([]string{"ETH"}, 1, 1000, make(chan *CoinAveragePriceStream)); err != nil {
+		t.Fatalf("resubscribe: %s", err)
+	}
+
+	subs := c.Subscriptions()
+	if len(subs) != 1 || subs[0].ID != 1 || !subs[0].Active {
+		t.Errorf("subscriptions: got %+v, want active subscription 1", subs)
+	}
+}